@@ -0,0 +1,48 @@
+package alexado
+
+// APLInterface is present in SupportedInterfaces when the device can render Alexa Presentation Language documents.
+type APLInterface struct {
+	Runtime APLRuntime `json:"runtime"`
+}
+
+// APLRuntime describes the APL or APLT runtime of the device.
+type APLRuntime struct {
+	MaxVersion string `json:"maxVersion"` // Latest version of the language the device supports, such as 1.1
+}
+
+// APLRenderDocumentDirective sends Alexa an APL document to render on the screen of the device.
+type APLRenderDocumentDirective struct {
+	Token       string                 `json:"token,omitempty"`       // Identifies the document in Alexa.Presentation.APL.ExecuteCommands directives and UserEvent requests
+	Document    interface{}            `json:"document"`              // APL document, such as a map decoded from the JSON exported from the authoring tool
+	Datasources map[string]interface{} `json:"datasources,omitempty"` // Data bound to the document
+}
+
+// DirectiveType returns "Alexa.Presentation.APL.RenderDocument".
+func (d APLRenderDocumentDirective) DirectiveType() string {
+	return "Alexa.Presentation.APL.RenderDocument"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d APLRenderDocumentDirective) MarshalJSON() ([]byte, error) {
+	type plain APLRenderDocumentDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// APLExecuteCommandsDirective sends Alexa APL commands to run against a document previously rendered.
+type APLExecuteCommandsDirective struct {
+	Token    string        `json:"token"`    // Token of the document the commands run against
+	Commands []interface{} `json:"commands"` // APL commands, such as SpeakItem or SetPage
+}
+
+// DirectiveType returns "Alexa.Presentation.APL.ExecuteCommands".
+func (d APLExecuteCommandsDirective) DirectiveType() string {
+	return "Alexa.Presentation.APL.ExecuteCommands"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d APLExecuteCommandsDirective) MarshalJSON() ([]byte, error) {
+	type plain APLExecuteCommandsDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
@@ -0,0 +1,109 @@
+package alexado
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func readAPLUserEvent(t *testing.T) AlexaRequest {
+	content, _ := ioutil.ReadFile("sample/apl_user_event.json")
+
+	var req AlexaRequest
+	if err := json.Unmarshal(content, &req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return req
+}
+
+func TestAPLUserEventUnmarshalsCorrectly(t *testing.T) {
+	req := readAPLUserEvent(t)
+
+	var actual, expected interface{}
+
+	actual, expected = req.Request.Type, APLUserEvent.String()
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	event, ok := req.Body().(*APLUserEventRequestBody)
+	if !ok {
+		t.Fatalf("Expected *APLUserEventRequestBody, got %T", req.Body())
+	}
+
+	actual, expected = event.Token, "menu"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = event.Arguments[1], "latte"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = string(event.Components), `{
+      "quantity": "2"
+    }`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = req.Context.System.Device.SupportedInterfaces.APL.Runtime.MaxVersion, "1.1"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestSupportsAPL(t *testing.T) {
+	if !readAPLUserEvent(t).SupportsAPL() {
+		t.Error("Expected the device to support APL")
+	}
+
+	if newTestRequest(LaunchRequest, "").SupportsAPL() {
+		t.Error("Expected the device not to support APL")
+	}
+}
+
+func TestAPLDirectivesToJSON(t *testing.T) {
+	document := map[string]interface{}{"type": "APL", "version": "1.1", "mainTemplate": map[string]interface{}{"items": []interface{}{}}}
+
+	res, err := NewResponseBuilder().
+		ForRequest(readAPLUserEvent(t)).
+		APLRenderDocument("menu", document, map[string]interface{}{"menu": map[string]interface{}{"title": "Drinks"}}).
+		APLExecuteCommands("menu", map[string]interface{}{"type": "SpeakItem", "componentId": "title"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	b, _ := json.Marshal(res.Response.Directives)
+	actual, expected := string(b), `[{"type":"Alexa.Presentation.APL.RenderDocument","token":"menu","document":{"mainTemplate":{"items":[]},"type":"APL","version":"1.1"},"datasources":{"menu":{"title":"Drinks"}}},`+
+		`{"type":"Alexa.Presentation.APL.ExecuteCommands","token":"menu","commands":[{"componentId":"title","type":"SpeakItem"}]}]`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestResponseBuilderReportsAPLConflicts(t *testing.T) {
+	document := json.RawMessage(`{"type":"APL","version":"1.1"}`)
+
+	tests := []*ResponseBuilder{
+		NewResponseBuilder().ForRequest(newTestRequest(LaunchRequest, "")).APLRenderDocument("menu", document, nil),
+		NewResponseBuilder().ForRequest(newTestRequest(LaunchRequest, "")).APLExecuteCommands("menu", map[string]string{"type": "Idle"}),
+		NewResponseBuilder().APLRenderDocument("menu", nil, nil),
+		NewResponseBuilder().APLExecuteCommands("", map[string]string{"type": "Idle"}),
+		NewResponseBuilder().APLExecuteCommands("menu"),
+	}
+
+	for i, b := range tests {
+		_, err := b.Build()
+		if _, ok := err.(*BuilderError); !ok {
+			t.Errorf("Expected *BuilderError for case %d, got %v", i, err)
+		}
+	}
+
+	if _, err := NewResponseBuilder().APLRenderDocument("menu", document, nil).Build(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package alexado
+
+import (
+	"context"
+	"fmt"
+)
+
+// ApplicationIDError is returned when a request was not intended for one of the allowed skills
+type ApplicationIDError struct {
+	SessionApplicationID string // Session.Application.ApplicationID of the rejected request
+	SystemApplicationID  string // Context.System.Application.ApplicationID of the rejected request
+	ApplicationID        string // Application ID checked against the allowed skills, empty on a mismatch
+	Mismatch             bool   // True when the session and system application IDs differ
+}
+
+func (e *ApplicationIDError) Error() string {
+	if e.Mismatch {
+		return fmt.Sprintf("alexado: session application ID %q does not match system application ID %q", e.SessionApplicationID, e.SystemApplicationID)
+	}
+
+	return fmt.Sprintf("alexado: application ID %q is not allowed", e.ApplicationID)
+}
+
+// ApplicationIDValidator checks that requests were intended for one of a set of skills
+type ApplicationIDValidator struct {
+	allowed map[string]bool
+}
+
+// NewApplicationIDValidator returns an ApplicationIDValidator accepting requests for the skills identified by ids.
+func NewApplicationIDValidator(ids ...string) *ApplicationIDValidator {
+	v := &ApplicationIDValidator{allowed: make(map[string]bool)}
+	v.Allow(ids...)
+
+	return v
+}
+
+// Allow adds ids to the set of allowed skill IDs.
+func (v *ApplicationIDValidator) Allow(ids ...string) {
+	for _, id := range ids {
+		v.allowed[id] = true
+	}
+}
+
+// Validate checks Session.Application and Context.System.Application of req against the allowed skill IDs.
+// Requests sent outside of a session, such as AudioPlayer requests, are only checked against Context.System.Application.
+func (v *ApplicationIDValidator) Validate(req AlexaRequest) error {
+	sessionID := req.Session.Application.ApplicationID
+	systemID := req.Context.System.Application.ApplicationID
+
+	err := &ApplicationIDError{SessionApplicationID: sessionID, SystemApplicationID: systemID}
+
+	if sessionID != "" && systemID != "" && sessionID != systemID {
+		err.Mismatch = true
+		return err
+	}
+
+	id := systemID
+	if id == "" {
+		id = sessionID
+	}
+
+	if !v.allowed[id] {
+		err.ApplicationID = id
+		return err
+	}
+
+	return nil
+}
+
+// Middleware returns Middleware rejecting requests that fail Validate with an *ApplicationIDError.
+func (v *ApplicationIDValidator) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+			if err := v.Validate(req); err != nil {
+				return AlexaResponse{}, err
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package alexado
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+const testApplicationID = "amzn1.ask.skill.2604e1db-063f-4f29-a411-6eba27b9dc34"
+
+func newApplicationRequest(sessionID, systemID string) AlexaRequest {
+	req := AlexaRequest{}
+	req.Session.Application.ApplicationID = sessionID
+	req.Context.System.Application.ApplicationID = systemID
+
+	return req
+}
+
+func TestApplicationIDValidatorAccepts(t *testing.T) {
+	v := NewApplicationIDValidator("amzn1.ask.skill.other", testApplicationID)
+
+	if err := v.Validate(newApplicationRequest(testApplicationID, testApplicationID)); err != nil {
+		t.Errorf("Expected request to be accepted, got %v", err)
+	}
+
+	// Requests outside of a session, such as AudioPlayer requests, carry no session
+	if err := v.Validate(newApplicationRequest("", testApplicationID)); err != nil {
+		t.Errorf("Expected sessionless request to be accepted, got %v", err)
+	}
+}
+
+func TestApplicationIDValidatorRejects(t *testing.T) {
+	v := NewApplicationIDValidator(testApplicationID)
+
+	tests := []struct {
+		req      AlexaRequest
+		mismatch bool
+	}{
+		{newApplicationRequest("amzn1.ask.skill.other", "amzn1.ask.skill.other"), false},
+		{newApplicationRequest("", ""), false},
+		{newApplicationRequest(testApplicationID, "amzn1.ask.skill.other"), true},
+		{newApplicationRequest("amzn1.ask.skill.other", testApplicationID), true},
+	}
+
+	for _, test := range tests {
+		err, ok := v.Validate(test.req).(*ApplicationIDError)
+		if !ok {
+			t.Errorf("Expected *ApplicationIDError for %+v", test.req.Session.Application)
+			continue
+		}
+
+		if err.Mismatch != test.mismatch {
+			t.Errorf("'%t' != '%t'", err.Mismatch, test.mismatch)
+		}
+	}
+}
+
+func TestApplicationIDErrorReportsCheckedID(t *testing.T) {
+	v := NewApplicationIDValidator(testApplicationID)
+
+	err := v.Validate(newApplicationRequest("amzn1.ask.skill.other", ""))
+	actual, expected := err.Error(), `alexado: application ID "amzn1.ask.skill.other" is not allowed`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	err = v.Validate(newApplicationRequest("", "amzn1.ask.skill.system"))
+	actual, expected = err.Error(), `alexado: application ID "amzn1.ask.skill.system" is not allowed`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestApplicationIDValidatorMiddlewareMapsToBadRequest(t *testing.T) {
+	v := NewApplicationIDValidator("amzn1.ask.skill.other")
+	h := NewHandler(Chain(respondWith("1.0"), v.Middleware()))
+
+	content := `{"version":"1.0","context":{"System":{"application":{"applicationId":"` + testApplicationID + `"}}}}`
+	w := serve(h, http.MethodPost, content)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("'%d' != '%d'", w.Code, http.StatusBadRequest)
+	}
+
+	v.Allow(testApplicationID)
+	fn := v.Middleware()(respondWith("1.0"))
+	if _, err := fn(context.Background(), newApplicationRequest(testApplicationID, testApplicationID)); err != nil {
+		t.Errorf("Expected request to be accepted, got %v", err)
+	}
+}
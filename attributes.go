@@ -0,0 +1,98 @@
+package alexado
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Attributes are key value pairs. Values are kept as raw JSON so that numbers, booleans, arrays and objects
+// round-trip losslessly between requests and responses.
+type Attributes map[string]json.RawMessage
+
+// AttributeError is returned when an attribute is missing or cannot be decoded into the requested type
+type AttributeError struct {
+	Key string // Key of the attribute
+	Err error  // Decoding error. Nil when the attribute is missing.
+}
+
+func (e *AttributeError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("alexado: attribute %q is not set", e.Key)
+	}
+
+	return fmt.Sprintf("alexado: attribute %q: %v", e.Key, e.Err)
+}
+
+// Has reports whether the attribute key is set.
+func (a Attributes) Has(key string) bool {
+	_, ok := a[key]
+
+	return ok
+}
+
+// Get decodes the attribute key into v, which must be a pointer.
+func (a Attributes) Get(key string, v interface{}) error {
+	raw, ok := a[key]
+	if !ok {
+		return &AttributeError{Key: key}
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return &AttributeError{Key: key, Err: err}
+	}
+
+	return nil
+}
+
+// GetString returns the attribute key as a string.
+func (a Attributes) GetString(key string) (string, error) {
+	var s string
+	err := a.Get(key, &s)
+
+	return s, err
+}
+
+// GetInt returns the attribute key as an int.
+func (a Attributes) GetInt(key string) (int, error) {
+	var i int
+	err := a.Get(key, &i)
+
+	return i, err
+}
+
+// GetFloat returns the attribute key as a float64.
+func (a Attributes) GetFloat(key string) (float64, error) {
+	var f float64
+	err := a.Get(key, &f)
+
+	return f, err
+}
+
+// GetBool returns the attribute key as a bool.
+func (a Attributes) GetBool(key string) (bool, error) {
+	var b bool
+	err := a.Get(key, &b)
+
+	return b, err
+}
+
+// Set stores v, encoded as JSON, under key. A nil Attributes map is allocated on first use.
+func (a *Attributes) Set(key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return &AttributeError{Key: key, Err: err}
+	}
+
+	if *a == nil {
+		*a = make(Attributes)
+	}
+
+	(*a)[key] = raw
+
+	return nil
+}
+
+// Delete removes the attribute key.
+func (a Attributes) Delete(key string) {
+	delete(a, key)
+}
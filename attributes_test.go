@@ -0,0 +1,99 @@
+package alexado
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testSessionAttributes = `{"session":{"attributes":{"context":"update","count":3,"done":false,"ids":[1,2],"note":{"title":"Rain","day":"Friday"}}}}`
+
+type testNote struct {
+	Title string `json:"title"`
+	Day   string `json:"day"`
+}
+
+func TestAttributesTypedGetters(t *testing.T) {
+	var areq AlexaRequest
+	if err := json.Unmarshal([]byte(testSessionAttributes), &areq); err != nil {
+		t.Fatal(err)
+	}
+
+	a := areq.Session.Attributes
+
+	s, err := a.GetString("context")
+	if err != nil || s != "update" {
+		t.Errorf("'%s' != '%s' (%v)", s, "update", err)
+	}
+
+	i, err := a.GetInt("count")
+	if err != nil || i != 3 {
+		t.Errorf("'%d' != '%d' (%v)", i, 3, err)
+	}
+
+	b, err := a.GetBool("done")
+	if err != nil || b {
+		t.Errorf("'%t' != '%t' (%v)", b, false, err)
+	}
+
+	var ids []int
+	if err := a.Get("ids", &ids); err != nil || len(ids) != 2 || ids[1] != 2 {
+		t.Errorf("'%v' != '%v' (%v)", ids, []int{1, 2}, err)
+	}
+
+	var note testNote
+	if err := a.Get("note", &note); err != nil || note.Day != "Friday" {
+		t.Errorf("'%v' != '%s' (%v)", note, "Friday", err)
+	}
+}
+
+func TestAttributesErrors(t *testing.T) {
+	var a Attributes
+	a.Set("context", "update")
+
+	if _, err := a.GetInt("context"); err == nil {
+		t.Error("Expected error decoding a string as an int")
+	}
+
+	_, err := a.GetString("missing")
+	attrErr, ok := err.(*AttributeError)
+	if !ok || attrErr.Err != nil || attrErr.Key != "missing" {
+		t.Errorf("Expected missing *AttributeError, got %v", err)
+	}
+
+	if err := a.Set("channel", make(chan int)); err == nil {
+		t.Error("Expected error setting a value that cannot be encoded")
+	}
+}
+
+func TestAttributesSetAndDelete(t *testing.T) {
+	var a Attributes
+	a.Set("note", testNote{Title: "Rain", Day: "Friday"})
+	a.Set("count", 4)
+
+	if !a.Has("note") {
+		t.Error("Expected note to be set")
+	}
+
+	a.Delete("note")
+	if a.Has("note") {
+		t.Error("Expected note to be deleted")
+	}
+
+	i, _ := a.GetInt("count")
+	if i != 4 {
+		t.Errorf("'%d' != '%d'", i, 4)
+	}
+}
+
+func TestAttributesRoundTrip(t *testing.T) {
+	var areq AlexaRequest
+	json.Unmarshal([]byte(testSessionAttributes), &areq)
+
+	ares := AlexaResponse{SessionAttributes: areq.Session.Attributes}
+	content, _ := json.Marshal(ares.SessionAttributes)
+
+	actual, expected := string(content), `{"context":"update","count":3,"done":false,"ids":[1,2],"note":{"title":"Rain","day":"Friday"}}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
@@ -0,0 +1,142 @@
+package alexado
+
+// AudioPlayerPlayDirective sends Alexa a command to stream the audio file identified by the specified audioItem.
+type AudioPlayerPlayDirective struct {
+	PlayBehavior PlayBehaviorType `json:"playBehavior"` // Describes playback behavior. One of ENQUEUE, REPLACE_ALL or REPLACE_ENQUEUED.
+	AudioItem    AudioItem        `json:"audioItem"`    // Contains an object providing information about the audio stream to play
+}
+
+// DirectiveType returns "AudioPlayer.Play".
+func (d AudioPlayerPlayDirective) DirectiveType() string {
+	return "AudioPlayer.Play"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d AudioPlayerPlayDirective) MarshalJSON() ([]byte, error) {
+	type plain AudioPlayerPlayDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// AudioItem contains an object providing information about the audio stream to play.
+type AudioItem struct {
+	Stream   Stream             `json:"stream"`             // Contains an object representing the audio stream to play
+	Metadata *AudioItemMetadata `json:"metadata,omitempty"` // Contains an object providing metadata about the audio to be displayed on screens
+}
+
+// Stream represents the audio stream to play.
+type Stream struct {
+	URL                   string `json:"url"`                             // Identifies the location of audio content at a remote HTTPS location
+	Token                 string `json:"token"`                           // An opaque token that represents the audio stream
+	ExpectedPreviousToken string `json:"expectedPreviousToken,omitempty"` // The token of the stream expected to play before this one. Required when playBehavior is ENQUEUE, and not allowed otherwise.
+	OffsetInMilliseconds  int    `json:"offsetInMilliseconds"`            // The timestamp in the stream from which Alexa should begin playback
+}
+
+// AudioItemMetadata provides metadata about the audio to be displayed on screens.
+type AudioItemMetadata struct {
+	Title           string        `json:"title,omitempty"`           // Title text to display
+	Subtitle        string        `json:"subtitle,omitempty"`        // Subtitle text to display
+	Art             *DisplayImage `json:"art,omitempty"`             // Image to display, such as album art
+	BackgroundImage *DisplayImage `json:"backgroundImage,omitempty"` // Background image to display
+}
+
+// DisplayImage references an image displayed on screens, available in one or more sizes.
+type DisplayImage struct {
+	ContentDescription string        `json:"contentDescription,omitempty"` // Description of the image for accessibility
+	Sources            []ImageSource `json:"sources"`                      // Locations of the image in different sizes
+}
+
+// ImageSource is the location of an image in a given size.
+type ImageSource struct {
+	URL          string `json:"url"`                    // Location of the image at a remote HTTPS location
+	Size         string `json:"size,omitempty"`         // Size of the image: X_SMALL, SMALL, MEDIUM, LARGE or X_LARGE
+	WidthPixels  int    `json:"widthPixels,omitempty"`  // Width of the image in pixels
+	HeightPixels int    `json:"heightPixels,omitempty"` // Height of the image in pixels
+}
+
+// AudioPlayerStopDirective stops the current audio playback.
+type AudioPlayerStopDirective struct{}
+
+// DirectiveType returns "AudioPlayer.Stop".
+func (d AudioPlayerStopDirective) DirectiveType() string {
+	return "AudioPlayer.Stop"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d AudioPlayerStopDirective) MarshalJSON() ([]byte, error) {
+	type plain AudioPlayerStopDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// AudioPlayerClearQueueDirective clears the audio playback queue.
+type AudioPlayerClearQueueDirective struct {
+	ClearBehavior ClearBehaviorType `json:"clearBehavior"` // Describes the clear queue behavior. One of CLEAR_ENQUEUED or CLEAR_ALL.
+}
+
+// DirectiveType returns "AudioPlayer.ClearQueue".
+func (d AudioPlayerClearQueueDirective) DirectiveType() string {
+	return "AudioPlayer.ClearQueue"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d AudioPlayerClearQueueDirective) MarshalJSON() ([]byte, error) {
+	type plain AudioPlayerClearQueueDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// ClearBehaviorType describes the clear queue behavior
+type ClearBehaviorType int
+
+const (
+	// ClearEnqueued clears the queue and continues to play the currently playing stream
+	ClearEnqueued ClearBehaviorType = iota + 1
+	// ClearAll clears the entire playback queue and stops the currently playing stream
+	ClearAll
+)
+
+var clearBehaviorTypeNames = [...]string{
+	"",
+	"CLEAR_ENQUEUED",
+	"CLEAR_ALL",
+}
+
+func (c ClearBehaviorType) String() string {
+	return enumString(clearBehaviorTypeNames[:], int(c))
+}
+
+// IsValid reports whether c is one of the ClearBehaviorType values. The unset zero value is not valid.
+func (c ClearBehaviorType) IsValid() bool {
+	return validEnum(clearBehaviorTypeNames[:], int(c))
+}
+
+// ClearBehaviorTypeValues returns every ClearBehaviorType value in declaration order.
+func ClearBehaviorTypeValues() []ClearBehaviorType {
+	values := make([]ClearBehaviorType, len(clearBehaviorTypeNames)-1)
+	for i := range values {
+		values[i] = ClearBehaviorType(i + 1)
+	}
+
+	return values
+}
+
+// ParseClearBehaviorType returns the ClearBehaviorType whose string value is s.
+func ParseClearBehaviorType(s string) (ClearBehaviorType, error) {
+	i, err := parseEnum("ClearBehaviorType", clearBehaviorTypeNames[:], s)
+
+	return ClearBehaviorType(i), err
+}
+
+// MarshalJSON encodes c as its string value.
+func (c ClearBehaviorType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("ClearBehaviorType", clearBehaviorTypeNames[:], int(c))
+}
+
+// UnmarshalJSON decodes c from its string value. Unknown values, an empty string or null leave c unset.
+func (c *ClearBehaviorType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(clearBehaviorTypeNames[:], data)
+	*c = ClearBehaviorType(i)
+
+	return err
+}
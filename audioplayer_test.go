@@ -0,0 +1,83 @@
+package alexado
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestClearBehaviorTypeString(t *testing.T) {
+	var actual, expected string
+
+	actual, expected = ClearEnqueued.String(), "CLEAR_ENQUEUED"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = ClearAll.String(), "CLEAR_ALL"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestAudioPlayerDirectivesToJSON(t *testing.T) {
+	metadata := &AudioItemMetadata{
+		Title:    "Episode 1",
+		Subtitle: "Alexa-do podcast",
+		Art:      &DisplayImage{Sources: []ImageSource{{URL: "https://example.com/art.png"}}},
+		BackgroundImage: &DisplayImage{
+			ContentDescription: "Studio",
+			Sources:            []ImageSource{{URL: "https://example.com/bg.png", Size: "LARGE", WidthPixels: 1200, HeightPixels: 800}},
+		},
+	}
+
+	res, err := NewResponseBuilder().
+		AudioPlayerPlay(ReplaceAll, Stream{URL: "https://example.com/1.mp3", Token: "episode-1", OffsetInMilliseconds: 1000}, metadata).
+		AudioPlayerStop().
+		AudioPlayerClearQueue(ClearAll).
+		Build()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, _ := json.Marshal(res.Response.Directives)
+
+	actual := string(content)
+	expected := `[{"type":"AudioPlayer.Play","playBehavior":"REPLACE_ALL","audioItem":{"stream":{"url":"https://example.com/1.mp3","token":"episode-1","offsetInMilliseconds":1000},` +
+		`"metadata":{"title":"Episode 1","subtitle":"Alexa-do podcast","art":{"sources":[{"url":"https://example.com/art.png"}]},` +
+		`"backgroundImage":{"contentDescription":"Studio","sources":[{"url":"https://example.com/bg.png","size":"LARGE","widthPixels":1200,"heightPixels":800}]}}}},` +
+		`{"type":"AudioPlayer.Stop"},{"type":"AudioPlayer.ClearQueue","clearBehavior":"CLEAR_ALL"}]`
+
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestAudioPlayerPlayValidation(t *testing.T) {
+	tests := []struct {
+		behavior PlayBehaviorType
+		stream   Stream
+	}{
+		{ReplaceAll, Stream{URL: "http://example.com/1.mp3", Token: "1"}},
+		{ReplaceAll, Stream{URL: "https://example.com/1.mp3"}},
+		{Enqueue, Stream{URL: "https://example.com/2.mp3", Token: "2"}},
+		{ReplaceEnqueued, Stream{URL: "https://example.com/2.mp3", Token: "2", ExpectedPreviousToken: "1"}},
+		{PlayBehaviorType(0), Stream{URL: "https://example.com/1.mp3", Token: "1"}},
+	}
+
+	for _, test := range tests {
+		_, err := NewResponseBuilder().AudioPlayerPlay(test.behavior, test.stream, nil).Build()
+		if _, ok := err.(*BuilderError); !ok {
+			t.Errorf("Expected *BuilderError for %v %v, got %v", test.behavior, test.stream, err)
+		}
+	}
+
+	_, err := NewResponseBuilder().AudioPlayerPlay(Enqueue, Stream{URL: "https://example.com/2.mp3", Token: "2", ExpectedPreviousToken: "1"}, nil).Build()
+	if err != nil {
+		t.Errorf("Expected enqueued stream to be accepted, got %v", err)
+	}
+
+	if _, err = NewResponseBuilder().AudioPlayerClearQueue(ClearBehaviorType(0)).Build(); err == nil {
+		t.Error("Expected an error for an unset clear behavior")
+	}
+}
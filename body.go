@@ -0,0 +1,230 @@
+package alexado
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RequestBody is the request object of an AlexaRequest decoded into the concrete type matching its type property.
+// Use a type switch to reach the fields of a given request type.
+type RequestBody interface {
+	Header() RequestHeader // Returns the properties common to every request type
+}
+
+// RequestHeader holds the properties common to every request type.
+type RequestHeader struct {
+	Type      string     `json:"type"`      // Type of the request, such as LaunchRequest or AudioPlayer.PlaybackStarted
+	RequestID string     `json:"requestId"` // Unique identifier for the specific request
+	Timestamp time.Time  `json:"timestamp"` // Time at which Alexa sent the request
+	Locale    LocaleType `json:"locale"`    // Locale of the request, such as en-US
+}
+
+// Header returns h.
+func (h RequestHeader) Header() RequestHeader {
+	return h
+}
+
+// LaunchRequestBody is sent when the user invokes the skill without providing a specific intent.
+type LaunchRequestBody struct {
+	RequestHeader
+}
+
+// IntentRequestBody is sent when the user speaks a command that maps to an intent.
+type IntentRequestBody struct {
+	RequestHeader
+	Intent      Intent          `json:"intent"`                // Intent requested by the user
+	DialogState DialogStateType `json:"dialogState,omitempty"` // State of the multi-turn dialog, when the intent has a dialog model
+}
+
+// SessionEndedRequestBody is sent when the current skill session ends for any reason other than the skill closing it.
+type SessionEndedRequestBody struct {
+	RequestHeader
+	Reason string     `json:"reason"`          // Describes why the session ended. One of USER_INITIATED, ERROR or EXCEEDED_MAX_REPROMPTS.
+	Error  *ErrorInfo `json:"error,omitempty"` // Describes the error that occurred when the reason is ERROR
+}
+
+// CanFulfillIntentRequestBody is sent to query whether the skill can understand and fulfill an intent.
+type CanFulfillIntentRequestBody struct {
+	RequestHeader
+	Intent Intent `json:"intent"` // Intent the skill is queried about
+}
+
+// AudioPlayerRequestBody is sent for AudioPlayer events, such as AudioPlayer.PlaybackStarted.
+type AudioPlayerRequestBody struct {
+	RequestHeader
+	Token                string         `json:"token"`                          // Opaque token of the stream the request is about
+	OffsetInMilliseconds int            `json:"offsetInMilliseconds"`           // Offset of the stream when the request was sent
+	Error                *ErrorInfo     `json:"error,omitempty"`                // AudioPlayer.PlaybackFailed: describes the error that occurred
+	CurrentPlaybackState *PlaybackState `json:"currentPlaybackState,omitempty"` // AudioPlayer.PlaybackFailed: state of playback when the error occurred
+}
+
+// PlaybackControllerRequestBody is sent when the user presses a playback button on a device, such as PlaybackController.NextCommandIssued.
+type PlaybackControllerRequestBody struct {
+	RequestHeader
+}
+
+// ElementSelectedRequestBody is sent when the user selects an item on a Display template.
+type ElementSelectedRequestBody struct {
+	RequestHeader
+	Token string `json:"token"` // Token of the selected item
+}
+
+// ConnectionsResponseRequestBody is sent with the result of a task the skill delegated to Alexa, such as a purchase.
+type ConnectionsResponseRequestBody struct {
+	RequestHeader
+	Name    string            `json:"name"`    // Name of the task, such as Buy or Upsell
+	Status  ConnectionsStatus `json:"status"`  // Outcome of the task
+	Token   string            `json:"token"`   // Token sent with the Connections.SendRequest directive
+	Payload json.RawMessage   `json:"payload"` // Task specific result
+}
+
+// ConnectionsStatus is the outcome of a task delegated to Alexa.
+type ConnectionsStatus struct {
+	Code    string `json:"code"`    // HTTP like status code, such as "200"
+	Message string `json:"message"` // Describes the status
+}
+
+// APLUserEventRequestBody is sent when the user interacts with an APL document, such as pressing a button.
+type APLUserEventRequestBody struct {
+	RequestHeader
+	Token      string          `json:"token"`      // Token of the document sent with the Alexa.Presentation.APL.RenderDocument directive
+	Arguments  []interface{}   `json:"arguments"`  // Arguments of the SendEvent command that raised the event
+	Source     json.RawMessage `json:"source"`     // Component that raised the event
+	Components json.RawMessage `json:"components"` // Values of the components of the document with an id
+}
+
+// UnknownRequestBody holds request types this package does not model. Raw is the request object as received.
+type UnknownRequestBody struct {
+	RequestHeader
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and keeps its request object as received, so that Body can reach the properties
+// Request does not model.
+func (a *AlexaRequest) UnmarshalJSON(data []byte) error {
+	type plain AlexaRequest
+
+	envelope := struct {
+		*plain
+		Request json.RawMessage `json:"request"`
+	}{plain: (*plain)(a)}
+
+	// Like encoding/json, keep decoding past a value of the wrong type and report the first one.
+	err := json.Unmarshal(data, &envelope)
+	if _, ok := err.(*json.UnmarshalTypeError); err != nil && !ok {
+		return err
+	}
+
+	a.Request, a.raw = Request{}, envelope.Request
+	if len(a.raw) > 0 {
+		if rerr := json.Unmarshal(a.raw, &a.Request); err == nil {
+			err = rerr
+		}
+	}
+
+	a.unknown = nil
+
+	var generic interface{}
+	if json.Unmarshal(data, &generic) == nil {
+		a.unknown = unknownEnumValues(reflect.TypeOf(*a), generic, "")
+		sort.Slice(a.unknown, func(i, j int) bool { return a.unknown[i].Path < a.unknown[j].Path })
+	}
+
+	return err
+}
+
+// UnknownValues returns an *EnumError for every value of the decoded request that is not one of the values of its
+// enumeration, ordered by path. Those values decode to unset rather than failing the request, so that values Alexa
+// adds later do not break the skill; UnknownValues tells them apart from values that were missing.
+func (a AlexaRequest) UnknownValues() []*EnumError {
+	return a.unknown
+}
+
+// RawRequest returns the request object as received, or nil when the AlexaRequest was not decoded from JSON.
+func (a AlexaRequest) RawRequest() json.RawMessage {
+	return a.raw
+}
+
+// Body returns the request object decoded into the concrete type matching its type property, such as
+// *IntentRequestBody or *AudioPlayerRequestBody. Request types this package does not model are returned as
+// *UnknownRequestBody. The properties Request models are copied from Request on every call, so changes made to it
+// after decoding, such as by middleware, are reflected. The other properties are read from the request object as received.
+func (a AlexaRequest) Body() RequestBody {
+	body, err := decodeRequestBody(a.Request.Type, a.raw)
+	if err != nil {
+		body = &UnknownRequestBody{Raw: a.raw}
+	}
+
+	r := a.Request
+	header := RequestHeader{Type: r.Type, RequestID: r.RequestID, Timestamp: r.Timestamp, Locale: r.Locale}
+
+	switch b := body.(type) {
+	case *LaunchRequestBody:
+		b.RequestHeader = header
+	case *IntentRequestBody:
+		b.RequestHeader, b.Intent, b.DialogState = header, r.Intent, r.DialogState
+	case *SessionEndedRequestBody:
+		b.RequestHeader, b.Error = header, r.Error
+	case *CanFulfillIntentRequestBody:
+		b.RequestHeader, b.Intent = header, r.Intent
+	case *ElementSelectedRequestBody:
+		b.RequestHeader, b.Token = header, r.Token
+	case *ConnectionsResponseRequestBody:
+		b.RequestHeader, b.Token = header, r.Token
+	case *APLUserEventRequestBody:
+		b.RequestHeader, b.Token = header, r.Token
+	case *AudioPlayerRequestBody:
+		b.RequestHeader, b.Token, b.OffsetInMilliseconds = header, r.Token, r.OffsetInMilliseconds
+		b.Error, b.CurrentPlaybackState = r.Error, r.CurrentPlaybackState
+	case *PlaybackControllerRequestBody:
+		b.RequestHeader = header
+	case *UnknownRequestBody:
+		b.RequestHeader = header
+		if len(b.Raw) == 0 {
+			b.Raw, _ = json.Marshal(r)
+		}
+	}
+
+	return body
+}
+
+// decodeRequestBody decodes raw, when present, into the concrete type matching typ.
+func decodeRequestBody(typ string, raw json.RawMessage) (RequestBody, error) {
+	var body RequestBody
+
+	switch {
+	case typ == LaunchRequest.String():
+		body = &LaunchRequestBody{}
+	case typ == IntentRequest.String():
+		body = &IntentRequestBody{}
+	case typ == SessionEndedRequest.String():
+		body = &SessionEndedRequestBody{}
+	case typ == CanFulfillIntentRequest.String():
+		body = &CanFulfillIntentRequestBody{}
+	case typ == DisplayElementSelected.String():
+		body = &ElementSelectedRequestBody{}
+	case typ == ConnectionsResponse.String():
+		body = &ConnectionsResponseRequestBody{}
+	case typ == APLUserEvent.String():
+		body = &APLUserEventRequestBody{}
+	case strings.HasPrefix(typ, "AudioPlayer."):
+		body = &AudioPlayerRequestBody{}
+	case strings.HasPrefix(typ, "PlaybackController."):
+		body = &PlaybackControllerRequestBody{}
+	default:
+		return &UnknownRequestBody{Raw: raw}, nil
+	}
+
+	if len(raw) == 0 || string(raw) == "null" {
+		return body, nil
+	}
+
+	if err := json.Unmarshal(raw, body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
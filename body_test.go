@@ -0,0 +1,204 @@
+package alexado
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func decodeTestRequest(t *testing.T, request string) AlexaRequest {
+	t.Helper()
+
+	var areq AlexaRequest
+	if err := json.Unmarshal([]byte(`{"version":"1.0","request":`+request+`}`), &areq); err != nil {
+		t.Fatal(err)
+	}
+
+	return areq
+}
+
+func TestBodyAudioPlayerRequest(t *testing.T) {
+	content, _ := ioutil.ReadFile("sample/playback_failed.json")
+
+	var areq AlexaRequest
+	json.Unmarshal(content, &areq)
+
+	body, ok := areq.Body().(*AudioPlayerRequestBody)
+	if !ok {
+		t.Fatalf("Expected *AudioPlayerRequestBody, got %T", areq.Body())
+	}
+
+	if body.Error.Type != "MEDIA_ERROR_SERVICE_UNAVAILABLE" {
+		t.Errorf("'%s' != '%s'", body.Error.Type, "MEDIA_ERROR_SERVICE_UNAVAILABLE")
+	}
+
+	if body.Header().RequestID != "amzn1.echo-api.request.0b25a8a1-ea2b-4a8e-bd8e-bf2b8ec5eb8f" {
+		t.Errorf("Unexpected request ID %s", body.Header().RequestID)
+	}
+}
+
+func TestBodyRequestTypes(t *testing.T) {
+	var actual, expected interface{}
+
+	areq := decodeTestRequest(t, `{"type":"LaunchRequest","requestId":"1","locale":"en-GB"}`)
+	launch, ok := areq.Body().(*LaunchRequestBody)
+	if !ok {
+		t.Fatalf("Expected *LaunchRequestBody, got %T", areq.Body())
+	}
+
+	actual, expected = launch.Locale, EnGb
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	areq = decodeTestRequest(t, `{"type":"IntentRequest","intent":{"name":"MyCustomIntent","slots":{"day":{"name":"day","value":"Friday"}}}}`)
+	intent, ok := areq.Body().(*IntentRequestBody)
+	if !ok {
+		t.Fatalf("Expected *IntentRequestBody, got %T", areq.Body())
+	}
+
+	actual, expected = intent.Intent.Slots["day"].Value, "Friday"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	areq = decodeTestRequest(t, `{"type":"SessionEndedRequest","reason":"ERROR","error":{"type":"INVALID_RESPONSE","message":"Bad"}}`)
+	ended, ok := areq.Body().(*SessionEndedRequestBody)
+	if !ok {
+		t.Fatalf("Expected *SessionEndedRequestBody, got %T", areq.Body())
+	}
+
+	actual, expected = ended.Reason+" "+ended.Error.Type, "ERROR INVALID_RESPONSE"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	areq = decodeTestRequest(t, `{"type":"CanFulfillIntentRequest","intent":{"name":"MyCustomIntent"}}`)
+	if _, ok := areq.Body().(*CanFulfillIntentRequestBody); !ok {
+		t.Errorf("Expected *CanFulfillIntentRequestBody, got %T", areq.Body())
+	}
+
+	areq = decodeTestRequest(t, `{"type":"PlaybackController.NextCommandIssued"}`)
+	if _, ok := areq.Body().(*PlaybackControllerRequestBody); !ok {
+		t.Errorf("Expected *PlaybackControllerRequestBody, got %T", areq.Body())
+	}
+
+	areq = decodeTestRequest(t, `{"type":"Display.ElementSelected","token":"item-1"}`)
+	selected, ok := areq.Body().(*ElementSelectedRequestBody)
+	if !ok {
+		t.Fatalf("Expected *ElementSelectedRequestBody, got %T", areq.Body())
+	}
+
+	actual, expected = selected.Token, "item-1"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	areq = decodeTestRequest(t, `{"type":"Connections.Response","name":"Buy","status":{"code":"200","message":"OK"},"token":"t","payload":{"purchaseResult":"ACCEPTED"}}`)
+	connections, ok := areq.Body().(*ConnectionsResponseRequestBody)
+	if !ok {
+		t.Fatalf("Expected *ConnectionsResponseRequestBody, got %T", areq.Body())
+	}
+
+	actual, expected = connections.Status.Code+" "+string(connections.Payload), `200 {"purchaseResult":"ACCEPTED"}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestBodyUnknownRequestType(t *testing.T) {
+	request := `{"type":"Messaging.MessageReceived","requestId":"1","message":{"key":"value"}}`
+	areq := decodeTestRequest(t, request)
+
+	unknown, ok := areq.Body().(*UnknownRequestBody)
+	if !ok {
+		t.Fatalf("Expected *UnknownRequestBody, got %T", areq.Body())
+	}
+
+	var actual, expected interface{}
+
+	actual, expected = unknown.Type, "Messaging.MessageReceived"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = string(unknown.Raw), request
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = string(areq.RawRequest()), request
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestBodyOfHandBuiltRequest(t *testing.T) {
+	areq := newTestRequest(IntentRequest, "MyCustomIntent")
+
+	intent, ok := areq.Body().(*IntentRequestBody)
+	if !ok {
+		t.Fatalf("Expected *IntentRequestBody, got %T", areq.Body())
+	}
+
+	if intent.Intent.Name != "MyCustomIntent" {
+		t.Errorf("'%s' != '%s'", intent.Intent.Name, "MyCustomIntent")
+	}
+
+	if areq.RawRequest() != nil {
+		t.Errorf("Expected no raw request, got %s", areq.RawRequest())
+	}
+}
+
+func TestBodyReflectsChangesToRequest(t *testing.T) {
+	areq := decodeTestRequest(t, `{"type":"SessionEndedRequest","requestId":"1","reason":"USER_INITIATED","error":{"type":"INVALID_RESPONSE"}}`)
+	areq.Request.Locale = EnGb
+	areq.Request.Error = nil
+
+	ended, ok := areq.Body().(*SessionEndedRequestBody)
+	if !ok {
+		t.Fatalf("Expected *SessionEndedRequestBody, got %T", areq.Body())
+	}
+
+	var actual, expected interface{}
+
+	actual, expected = ended.Locale, EnGb
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = ended.Reason, "USER_INITIATED"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if ended.Error != nil {
+		t.Errorf("Expected the cleared error to be removed, got %+v", ended.Error)
+	}
+
+	areq.Request.Type = IntentRequest.String()
+	areq.Request.Intent.Name = "MyCustomIntent"
+
+	intent, ok := areq.Body().(*IntentRequestBody)
+	if !ok {
+		t.Fatalf("Expected *IntentRequestBody, got %T", areq.Body())
+	}
+
+	actual, expected = intent.Intent.Name, "MyCustomIntent"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	areq = decodeTestRequest(t, `{"type":"IntentRequest","intent":{"name":"MyCustomIntent","slots":{"x":{"name":"x","value":"1"},"y":{"name":"y","value":"2"}}}}`)
+	delete(areq.Request.Intent.Slots, "y")
+
+	intent, ok = areq.Body().(*IntentRequestBody)
+	if !ok {
+		t.Fatalf("Expected *IntentRequestBody, got %T", areq.Body())
+	}
+
+	actual, expected = len(intent.Intent.Slots), 1
+	if actual != expected {
+		t.Errorf("'%d' != '%d'", actual, expected)
+	}
+}
@@ -0,0 +1,393 @@
+package alexado
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cardRequestTypes lists the request types whose responses can carry a card
+var cardRequestTypes = map[string]bool{
+	LaunchRequest.String():           true,
+	IntentRequest.String():           true,
+	CanFulfillIntentRequest.String(): true,
+	"GameEngine.InputHandlerEvent":   true,
+}
+
+// BuilderError lists the conflicting choices made while building a response
+type BuilderError struct {
+	Problems []string
+}
+
+func (e *BuilderError) Error() string {
+	return "alexado: invalid response: " + strings.Join(e.Problems, "; ")
+}
+
+// ResponseBuilder builds an AlexaResponse. Conflicting choices are collected and reported by Build.
+type ResponseBuilder struct {
+	response AlexaResponse
+	request  *AlexaRequest
+	problems []string
+}
+
+// NewResponseBuilder returns a ResponseBuilder for a version "1.0" response.
+func NewResponseBuilder() *ResponseBuilder {
+	return &ResponseBuilder{response: AlexaResponse{Version: "1.0"}}
+}
+
+// ForRequest records the request being answered so that choices it does not allow are reported.
+func (b *ResponseBuilder) ForRequest(req AlexaRequest) *ResponseBuilder {
+	b.request = &req
+
+	return b
+}
+
+// Speak sets the output speech to plain text.
+func (b *ResponseBuilder) Speak(text string) *ResponseBuilder {
+	if b.response.Response.OutputSpeech != nil {
+		b.fail("output speech is already set")
+	}
+
+	b.response.Response.OutputSpeech = &OutputSpeech{Type: PlainText, Text: text}
+
+	return b
+}
+
+// SpeakSSML sets the output speech to SSML. ssml is wrapped in a speak tag when it does not have one, and must pass ValidateSSML.
+func (b *ResponseBuilder) SpeakSSML(ssml string) *ResponseBuilder {
+	if b.response.Response.OutputSpeech != nil {
+		b.fail("output speech is already set")
+	}
+
+	b.response.Response.OutputSpeech = &OutputSpeech{Type: SSML, SSML: b.validSSML(ssml)}
+
+	return b
+}
+
+// Reprompt sets the plain text speech used when the user does not respond.
+func (b *ResponseBuilder) Reprompt(text string) *ResponseBuilder {
+	return b.reprompt(OutputSpeech{Type: PlainText, Text: text})
+}
+
+// RepromptSSML sets the SSML speech used when the user does not respond. ssml must pass ValidateSSML.
+func (b *ResponseBuilder) RepromptSSML(ssml string) *ResponseBuilder {
+	return b.reprompt(OutputSpeech{Type: SSML, SSML: b.validSSML(ssml)})
+}
+
+func (b *ResponseBuilder) reprompt(speech OutputSpeech) *ResponseBuilder {
+	if b.response.Response.Reprompt != nil {
+		b.fail("reprompt is already set")
+	}
+
+	b.response.Response.Reprompt = &Reprompt{OutputSpeech: speech}
+
+	return b
+}
+
+// SimpleCard adds a card with a title and plain text content.
+func (b *ResponseBuilder) SimpleCard(title, content string) *ResponseBuilder {
+	return b.card(Card{Type: Simple, Title: title, Content: content})
+}
+
+// StandardCard adds a card with a title, text content and an optional image.
+func (b *ResponseBuilder) StandardCard(title, text string, image *Image) *ResponseBuilder {
+	return b.card(Card{Type: Standard, Title: title, Text: text, Image: image})
+}
+
+// LinkAccountCard adds a card asking the user to link their account.
+func (b *ResponseBuilder) LinkAccountCard() *ResponseBuilder {
+	return b.card(Card{Type: LinkAccount})
+}
+
+// AskForPermissionsConsentCard adds a card asking the user to consent to permissions.
+func (b *ResponseBuilder) AskForPermissionsConsentCard(permissions ...string) *ResponseBuilder {
+	if len(permissions) == 0 {
+		b.fail("a permissions consent card needs at least one permission")
+	}
+
+	return b.card(Card{Type: AskForPermissionsConsent, Permissions: permissions})
+}
+
+func (b *ResponseBuilder) card(card Card) *ResponseBuilder {
+	if b.response.Response.Card != nil {
+		b.fail("card is already set")
+	}
+
+	if b.request != nil && !cardRequestTypes[b.request.Request.Type] {
+		b.fail(fmt.Sprintf("a response to %s cannot carry a card", b.request.Request.Type))
+	}
+
+	b.response.Response.Card = &card
+
+	return b
+}
+
+// AddDirective appends d to the response directives.
+func (b *ResponseBuilder) AddDirective(d ResponseDirective) *ResponseBuilder {
+	b.response.Response.Directives = append(b.response.Response.Directives, d)
+
+	return b
+}
+
+// AudioPlayerPlay adds an AudioPlayer.Play directive streaming stream with the given play behavior.
+// metadata is optional and only displayed on devices with a screen.
+func (b *ResponseBuilder) AudioPlayerPlay(behavior PlayBehaviorType, stream Stream, metadata *AudioItemMetadata) *ResponseBuilder {
+	if !behavior.IsValid() {
+		b.fail("play behavior must be ENQUEUE, REPLACE_ALL or REPLACE_ENQUEUED")
+	}
+
+	if !strings.HasPrefix(stream.URL, "https://") {
+		b.fail("audio stream URL must use https")
+	}
+
+	if stream.Token == "" {
+		b.fail("audio stream token is required")
+	}
+
+	if behavior == Enqueue && stream.ExpectedPreviousToken == "" {
+		b.fail("expectedPreviousToken is required when the play behavior is ENQUEUE")
+	}
+
+	if behavior != Enqueue && stream.ExpectedPreviousToken != "" {
+		b.fail("expectedPreviousToken is only allowed when the play behavior is ENQUEUE")
+	}
+
+	return b.AddDirective(AudioPlayerPlayDirective{
+		PlayBehavior: behavior,
+		AudioItem:    AudioItem{Stream: stream, Metadata: metadata},
+	})
+}
+
+// AudioPlayerStop adds an AudioPlayer.Stop directive.
+func (b *ResponseBuilder) AudioPlayerStop() *ResponseBuilder {
+	return b.AddDirective(AudioPlayerStopDirective{})
+}
+
+// AudioPlayerClearQueue adds an AudioPlayer.ClearQueue directive with the given clear behavior.
+func (b *ResponseBuilder) AudioPlayerClearQueue(behavior ClearBehaviorType) *ResponseBuilder {
+	if !behavior.IsValid() {
+		b.fail("clear behavior must be CLEAR_ENQUEUED or CLEAR_ALL")
+	}
+
+	return b.AddDirective(AudioPlayerClearQueueDirective{ClearBehavior: behavior})
+}
+
+// APLRenderDocument adds an Alexa.Presentation.APL.RenderDocument directive rendering document with datasources.
+// When the request is known, the device must support APL: check AlexaRequest.SupportsAPL before rendering.
+func (b *ResponseBuilder) APLRenderDocument(token string, document interface{}, datasources map[string]interface{}) *ResponseBuilder {
+	if document == nil {
+		b.fail("an APL document is required")
+	}
+
+	b.requireAPL()
+
+	return b.AddDirective(APLRenderDocumentDirective{Token: token, Document: document, Datasources: datasources})
+}
+
+// APLExecuteCommands adds an Alexa.Presentation.APL.ExecuteCommands directive running commands against the document
+// rendered with token.
+func (b *ResponseBuilder) APLExecuteCommands(token string, commands ...interface{}) *ResponseBuilder {
+	if token == "" {
+		b.fail("the token of the APL document is required")
+	}
+
+	if len(commands) == 0 {
+		b.fail("at least one APL command is required")
+	}
+
+	b.requireAPL()
+
+	return b.AddDirective(APLExecuteCommandsDirective{Token: token, Commands: commands})
+}
+
+func (b *ResponseBuilder) requireAPL() {
+	if b.request != nil && !b.request.SupportsAPL() {
+		b.fail("the device does not support APL")
+	}
+}
+
+// DialogDelegate adds a Dialog.Delegate directive letting Alexa handle the next turn of the dialog. updated is optional.
+func (b *ResponseBuilder) DialogDelegate(updated *Intent) *ResponseBuilder {
+	return b.AddDirective(DialogDelegateDirective{UpdatedIntent: updated})
+}
+
+// DialogElicitSlot adds a Dialog.ElicitSlot directive asking the user for the value of slot. updated is optional.
+func (b *ResponseBuilder) DialogElicitSlot(slot string, updated *Intent) *ResponseBuilder {
+	b.dialogSlot(slot, updated)
+
+	return b.AddDirective(DialogElicitSlotDirective{SlotToElicit: slot, UpdatedIntent: updated})
+}
+
+// DialogConfirmSlot adds a Dialog.ConfirmSlot directive asking the user to confirm the value of slot. updated is optional.
+func (b *ResponseBuilder) DialogConfirmSlot(slot string, updated *Intent) *ResponseBuilder {
+	b.dialogSlot(slot, updated)
+
+	return b.AddDirective(DialogConfirmSlotDirective{SlotToConfirm: slot, UpdatedIntent: updated})
+}
+
+// DialogConfirmIntent adds a Dialog.ConfirmIntent directive asking the user to confirm the intent. updated is optional.
+func (b *ResponseBuilder) DialogConfirmIntent(updated *Intent) *ResponseBuilder {
+	return b.AddDirective(DialogConfirmIntentDirective{UpdatedIntent: updated})
+}
+
+// DialogUpdateDynamicEntities adds a Dialog.UpdateDynamicEntities directive. types are required to replace the
+// dynamic entities, and not allowed to clear them.
+func (b *ResponseBuilder) DialogUpdateDynamicEntities(behavior UpdateBehaviorType, types ...DynamicEntityType) *ResponseBuilder {
+	if behavior == ReplaceEntities && len(types) == 0 {
+		b.fail("dynamic entities are required when the update behavior is REPLACE")
+	}
+
+	if behavior == ClearEntities && len(types) > 0 {
+		b.fail("dynamic entities are not allowed when the update behavior is CLEAR")
+	}
+
+	return b.AddDirective(DialogUpdateDynamicEntitiesDirective{UpdateBehavior: behavior, Types: types})
+}
+
+func (b *ResponseBuilder) dialogSlot(slot string, updated *Intent) {
+	if slot == "" {
+		b.fail("a slot name is required")
+		return
+	}
+
+	if updated != nil {
+		if _, ok := updated.Slots[slot]; !ok {
+			b.fail(fmt.Sprintf("the updated intent has no slot %s", slot))
+		}
+	}
+}
+
+// CanFulfillIntent answers a CanFulfillIntentRequest with c.
+func (b *ResponseBuilder) CanFulfillIntent(c CanFulfillIntent) *ResponseBuilder {
+	if b.response.Response.CanFulfillIntent != nil {
+		b.fail("canFulfillIntent is already set")
+	}
+
+	b.problems = append(b.problems, c.problems()...)
+	b.response.Response.CanFulfillIntent = &c
+
+	return b
+}
+
+// CanFulfillSlots answers a CanFulfillIntentRequest from the slots of the request intent, as NewCanFulfillIntent
+// does. It needs ForRequest.
+func (b *ResponseBuilder) CanFulfillSlots(check func(Slot) CanFulfillSlot) *ResponseBuilder {
+	if b.request == nil {
+		b.fail("canFulfillIntent can only be derived from a request given to ForRequest")
+		return b
+	}
+
+	return b.CanFulfillIntent(NewCanFulfillIntent(b.request.Request.Intent, check))
+}
+
+// EndSession sets whether the session ends after Alexa speaks the response. An explicit false is kept in the response.
+func (b *ResponseBuilder) EndSession(end bool) *ResponseBuilder {
+	if end {
+		b.response.Response.ShouldEndSession = SessionEnd
+	} else {
+		b.response.Response.ShouldEndSession = SessionKeepOpen
+	}
+
+	return b
+}
+
+// OmitEndSession leaves shouldEndSession out of the response, as required for AudioPlayer and other interface responses.
+func (b *ResponseBuilder) OmitEndSession() *ResponseBuilder {
+	b.response.Response.ShouldEndSession = SessionUnset
+
+	return b
+}
+
+// WithAttributes sets the session attributes passed back on the next request.
+func (b *ResponseBuilder) WithAttributes(a Attributes) *ResponseBuilder {
+	b.response.SessionAttributes = a
+
+	return b
+}
+
+// Build returns the AlexaResponse, or a *BuilderError describing every conflicting choice.
+func (b *ResponseBuilder) Build() (AlexaResponse, error) {
+	problems := append([]string{}, b.problems...)
+	res := b.response.Response
+
+	if res.Reprompt != nil && res.ShouldEndSession == SessionEnd {
+		problems = append(problems, "a reprompt cannot be used when the session ends")
+	}
+
+	if b.request != nil && b.request.Request.Type == SessionEndedRequest.String() {
+		if res.OutputSpeech != nil || res.Reprompt != nil || res.Card != nil || len(res.Directives) > 0 {
+			problems = append(problems, "a response to SessionEndedRequest cannot carry speech, cards or directives")
+		}
+	}
+
+	problems = append(problems, b.dialogProblems()...)
+
+	if res.CanFulfillIntent != nil && b.request != nil && b.request.Request.Type != CanFulfillIntentRequest.String() {
+		problems = append(problems, fmt.Sprintf("a response to %s cannot carry canFulfillIntent", b.request.Request.Type))
+	}
+
+	if len(problems) > 0 {
+		return AlexaResponse{}, &BuilderError{Problems: problems}
+	}
+
+	return b.response, nil
+}
+
+// dialogProblems checks the dialog directives of the response against the request and the rest of the response.
+func (b *ResponseBuilder) dialogProblems() []string {
+	res := b.response.Response
+
+	var problems []string
+	var dialog []string
+	for _, d := range res.Directives {
+		if !isDialogDirective(d) {
+			continue
+		}
+
+		dialog = append(dialog, d.DirectiveType())
+
+		if _, ok := d.(DialogDelegateDirective); ok && (res.OutputSpeech != nil || res.Reprompt != nil) {
+			problems = append(problems, "a response with a Dialog.Delegate directive cannot carry speech")
+		}
+	}
+
+	if len(dialog) == 0 {
+		return problems
+	}
+
+	if len(dialog) > 1 {
+		problems = append(problems, "a response can only carry one dialog directive, got "+strings.Join(dialog, ", "))
+	}
+
+	if res.ShouldEndSession == SessionEnd {
+		problems = append(problems, "a response with a dialog directive cannot end the session")
+	}
+
+	if b.request != nil && b.request.Request.Type != IntentRequest.String() {
+		problems = append(problems, fmt.Sprintf("a response to %s cannot carry %s", b.request.Request.Type, strings.Join(dialog, ", ")))
+	}
+
+	return problems
+}
+
+func (b *ResponseBuilder) fail(problem string) {
+	b.problems = append(b.problems, problem)
+}
+
+func (b *ResponseBuilder) validSSML(ssml string) string {
+	ssml = wrapSpeak(ssml)
+
+	if err, ok := ValidateSSML(ssml).(*SSMLError); ok {
+		b.problems = append(b.problems, err.Problems...)
+	}
+
+	return ssml
+}
+
+func wrapSpeak(ssml string) string {
+	trimmed := strings.TrimSpace(ssml)
+	if strings.HasPrefix(trimmed, "<speak>") && strings.HasSuffix(trimmed, "</speak>") {
+		return trimmed
+	}
+
+	return "<speak>" + ssml + "</speak>"
+}
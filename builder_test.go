@@ -0,0 +1,99 @@
+package alexado
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResponseBuilderBuildsResponse(t *testing.T) {
+	var a Attributes
+	a.Set("context", "create")
+
+	res, err := NewResponseBuilder().
+		ForRequest(newTestRequest(IntentRequest, "MyCustomIntent")).
+		SpeakSSML("Some good speech.").
+		Reprompt("Anything else?").
+		SimpleCard("Note", "Some good speech.").
+		AddDirective(Directive{Type: "InterfaceName.Directive"}).
+		WithAttributes(a).
+		Build()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, _ := res.ToJSON()
+	expected := `{"version":"1.0","sessionAttributes":{"context":"create"},"response":{"outputSpeech":{"type":"SSML","ssml":"\u003cspeak\u003eSome good speech.\u003c/speak\u003e"},"card":{"type":"Simple","title":"Note","content":"Some good speech."},"reprompt":{"outputSpeech":{"type":"PlainText","text":"Anything else?"}},"directives":[{"type":"InterfaceName.Directive"}]}}`
+
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestResponseBuilderCards(t *testing.T) {
+	image := &Image{SmallImageURL: "https://example.com/small.png"}
+
+	tests := []struct {
+		builder  *ResponseBuilder
+		expected Card
+	}{
+		{NewResponseBuilder().StandardCard("Title", "Text", image), Card{Type: Standard, Title: "Title", Text: "Text", Image: image}},
+		{NewResponseBuilder().LinkAccountCard(), Card{Type: LinkAccount}},
+	}
+
+	for _, test := range tests {
+		res, err := test.builder.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(*res.Response.Card, test.expected) {
+			t.Errorf("'%v' != '%v'", *res.Response.Card, test.expected)
+		}
+	}
+
+	res, _ := NewResponseBuilder().AskForPermissionsConsentCard("read::alexa:device:all:address").Build()
+	if res.Response.Card.Permissions[0] != "read::alexa:device:all:address" {
+		t.Errorf("'%v' != '%v'", res.Response.Card.Permissions, "read::alexa:device:all:address")
+	}
+}
+
+func TestResponseBuilderKeepsSpeakTag(t *testing.T) {
+	res, _ := NewResponseBuilder().SpeakSSML("<speak>Hi</speak>").Build()
+
+	actual, expected := res.Response.OutputSpeech.SSML, "<speak>Hi</speak>"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestResponseBuilderReportsConflicts(t *testing.T) {
+	tests := []*ResponseBuilder{
+		NewResponseBuilder().Speak("one").SpeakSSML("two"),
+		NewResponseBuilder().Reprompt("one").RepromptSSML("two"),
+		NewResponseBuilder().SimpleCard("one", "one").LinkAccountCard(),
+		NewResponseBuilder().AskForPermissionsConsentCard(),
+		NewResponseBuilder().Reprompt("one").EndSession(true),
+		NewResponseBuilder().ForRequest(newTestRequest(SessionEndedRequest, "")).Speak("bye"),
+		NewResponseBuilder().ForRequest(newTestRequest(SessionEndedRequest, "")).SimpleCard("one", "one"),
+	}
+
+	for i, b := range tests {
+		_, err := b.Build()
+		if _, ok := err.(*BuilderError); !ok {
+			t.Errorf("Expected *BuilderError for case %d, got %v", i, err)
+		}
+	}
+}
+
+func TestResponseBuilderSessionEnd(t *testing.T) {
+	res, _ := NewResponseBuilder().Speak("Anything else?").EndSession(false).Build()
+	if res.Response.ShouldEndSession != SessionKeepOpen {
+		t.Errorf("'%s' != '%s'", res.Response.ShouldEndSession, SessionKeepOpen)
+	}
+
+	res, _ = NewResponseBuilder().EndSession(true).OmitEndSession().Build()
+	if res.Response.ShouldEndSession != SessionUnset {
+		t.Errorf("'%s' != '%s'", res.Response.ShouldEndSession, SessionUnset)
+	}
+}
@@ -0,0 +1,134 @@
+package alexado
+
+import "sort"
+
+// CanFulfillIntent tells Alexa whether the skill can understand and fulfill the intent of a CanFulfillIntentRequest.
+type CanFulfillIntent struct {
+	CanFulfill CanFulfillType            `json:"canFulfill"`      // Whether the skill can fulfill the intent. YES, NO or MAYBE.
+	Slots      map[string]CanFulfillSlot `json:"slots,omitempty"` // Answer for each slot of the request carrying a value
+}
+
+// CanFulfillSlot tells Alexa whether the skill can understand and fulfill the value of a slot.
+type CanFulfillSlot struct {
+	CanUnderstand CanFulfillType `json:"canUnderstand"` // Whether the skill understands the value. YES, NO or MAYBE.
+	CanFulfill    CanFulfillType `json:"canFulfill"`    // Whether the skill can act on the value. YES or NO.
+}
+
+// NewCanFulfillIntent answers for the slots of intent carrying a value. check is called for each of them, and a nil
+// check understands and fulfills every slot. The intent can be fulfilled when every slot is understood and fulfilled,
+// maybe when some slots are only maybe understood, and not when any slot is not.
+func NewCanFulfillIntent(intent Intent, check func(Slot) CanFulfillSlot) CanFulfillIntent {
+	c := CanFulfillIntent{CanFulfill: CanFulfillYes}
+
+	for name, slot := range intent.Slots {
+		if len(slot.Values()) == 0 {
+			continue
+		}
+
+		answer := CanFulfillSlot{CanUnderstand: CanFulfillYes, CanFulfill: CanFulfillYes}
+		if check != nil {
+			answer = check(slot)
+		}
+
+		if c.Slots == nil {
+			c.Slots = make(map[string]CanFulfillSlot)
+		}
+		c.Slots[name] = answer
+
+		switch {
+		case answer.CanUnderstand == CanFulfillNo || answer.CanFulfill == CanFulfillNo:
+			c.CanFulfill = CanFulfillNo
+		case answer.CanUnderstand == CanFulfillMaybe && c.CanFulfill == CanFulfillYes:
+			c.CanFulfill = CanFulfillMaybe
+		}
+	}
+
+	return c
+}
+
+// problems lists the values of c Alexa does not accept.
+func (c CanFulfillIntent) problems() []string {
+	var problems []string
+
+	if !c.CanFulfill.IsValid() {
+		problems = append(problems, "canFulfill must be YES, NO or MAYBE")
+	}
+
+	names := make([]string, 0, len(c.Slots))
+	for name := range c.Slots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		slot := c.Slots[name]
+
+		if !slot.CanUnderstand.IsValid() {
+			problems = append(problems, "canUnderstand of slot "+name+" must be YES, NO or MAYBE")
+		}
+
+		if slot.CanFulfill != CanFulfillYes && slot.CanFulfill != CanFulfillNo {
+			problems = append(problems, "canFulfill of slot "+name+" must be YES or NO")
+		}
+	}
+
+	return problems
+}
+
+// CanFulfillType is the answer of a skill to a CanFulfillIntentRequest
+type CanFulfillType int
+
+const (
+	// CanFulfillYes indicates the skill can understand or fulfill the intent or slot
+	CanFulfillYes CanFulfillType = iota + 1
+	// CanFulfillNo indicates the skill cannot understand or fulfill the intent or slot
+	CanFulfillNo
+	// CanFulfillMaybe indicates the skill may be able to understand or fulfill the intent or slot
+	CanFulfillMaybe
+)
+
+var canFulfillTypeNames = [...]string{
+	"",
+	"YES",
+	"NO",
+	"MAYBE",
+}
+
+func (c CanFulfillType) String() string {
+	return enumString(canFulfillTypeNames[:], int(c))
+}
+
+// IsValid reports whether c is one of the CanFulfillType values. The unset zero value is not valid.
+func (c CanFulfillType) IsValid() bool {
+	return validEnum(canFulfillTypeNames[:], int(c))
+}
+
+// CanFulfillTypeValues returns every CanFulfillType value in declaration order.
+func CanFulfillTypeValues() []CanFulfillType {
+	values := make([]CanFulfillType, len(canFulfillTypeNames)-1)
+	for i := range values {
+		values[i] = CanFulfillType(i + 1)
+	}
+
+	return values
+}
+
+// ParseCanFulfillType returns the CanFulfillType whose string value is s.
+func ParseCanFulfillType(s string) (CanFulfillType, error) {
+	i, err := parseEnum("CanFulfillType", canFulfillTypeNames[:], s)
+
+	return CanFulfillType(i), err
+}
+
+// MarshalJSON encodes c as its string value.
+func (c CanFulfillType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("CanFulfillType", canFulfillTypeNames[:], int(c))
+}
+
+// UnmarshalJSON decodes c from its string value. Unknown values, an empty string or null leave c unset.
+func (c *CanFulfillType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(canFulfillTypeNames[:], data)
+	*c = CanFulfillType(i)
+
+	return err
+}
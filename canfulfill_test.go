@@ -0,0 +1,126 @@
+package alexado
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func newTestCanFulfillRequest(slots ...Slot) AlexaRequest {
+	req := newTestRequest(CanFulfillIntentRequest, "")
+	req.Request.Intent = Intent{Name: "OrderDrinkIntent", Slots: map[string]Slot{"drink": {Name: "drink"}, "size": {Name: "size"}}}
+
+	for _, slot := range slots {
+		req.Request.Intent.Slots[slot.Name] = slot
+	}
+
+	return req
+}
+
+func TestCanFulfillTypeString(t *testing.T) {
+	var actual, expected string
+
+	actual, expected = CanFulfillYes.String(), "YES"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = CanFulfillNo.String(), "NO"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = CanFulfillMaybe.String(), "MAYBE"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestNewCanFulfillIntent(t *testing.T) {
+	check := func(s Slot) CanFulfillSlot {
+		switch s.Value {
+		case "latte", "large":
+			return CanFulfillSlot{CanUnderstand: CanFulfillYes, CanFulfill: CanFulfillYes}
+		case "flat white":
+			return CanFulfillSlot{CanUnderstand: CanFulfillMaybe, CanFulfill: CanFulfillYes}
+		default:
+			return CanFulfillSlot{CanUnderstand: CanFulfillNo, CanFulfill: CanFulfillNo}
+		}
+	}
+
+	tests := []struct {
+		intent   Intent
+		expected CanFulfillType
+		slots    int
+	}{
+		{newTestCanFulfillRequest().Request.Intent, CanFulfillYes, 0},
+		{newTestCanFulfillRequest(Slot{Name: "drink", Value: "latte"}, Slot{Name: "size", Value: "large"}).Request.Intent, CanFulfillYes, 2},
+		{newTestCanFulfillRequest(Slot{Name: "drink", Value: "flat white"}, Slot{Name: "size", Value: "large"}).Request.Intent, CanFulfillMaybe, 2},
+		{newTestCanFulfillRequest(Slot{Name: "drink", Value: "flat white"}, Slot{Name: "size", Value: "tiny"}).Request.Intent, CanFulfillNo, 2},
+		{newTestCanFulfillRequest(Slot{Name: "drink", Value: "beer"}).Request.Intent, CanFulfillNo, 1},
+	}
+
+	for i, test := range tests {
+		c := NewCanFulfillIntent(test.intent, check)
+
+		if c.CanFulfill != test.expected {
+			t.Errorf("Case %d: '%s' != '%s'", i, c.CanFulfill, test.expected)
+		}
+
+		if len(c.Slots) != test.slots {
+			t.Errorf("Case %d: '%d' != '%d'", i, len(c.Slots), test.slots)
+		}
+	}
+
+	c := NewCanFulfillIntent(newTestCanFulfillRequest(Slot{Name: "drink", Value: "beer"}).Request.Intent, nil)
+	expected := map[string]CanFulfillSlot{"drink": {CanUnderstand: CanFulfillYes, CanFulfill: CanFulfillYes}}
+	if !reflect.DeepEqual(c.Slots, expected) {
+		t.Errorf("'%v' != '%v'", c.Slots, expected)
+	}
+}
+
+func TestResponseBuilderCanFulfillSlots(t *testing.T) {
+	req := newTestCanFulfillRequest(Slot{Name: "drink", Value: "latte"})
+
+	res, err := NewResponseBuilder().ForRequest(req).CanFulfillSlots(nil).Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	b, _ := json.Marshal(res.Response)
+	actual, expected := string(b), `{"canFulfillIntent":{"canFulfill":"YES","slots":{"drink":{"canUnderstand":"YES","canFulfill":"YES"}}}}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestResponseBuilderReportsCanFulfillConflicts(t *testing.T) {
+	valid := CanFulfillIntent{CanFulfill: CanFulfillNo}
+
+	tests := []*ResponseBuilder{
+		NewResponseBuilder().ForRequest(newTestRequest(IntentRequest, "OrderDrinkIntent")).CanFulfillIntent(valid),
+		NewResponseBuilder().ForRequest(newTestRequest(LaunchRequest, "")).CanFulfillIntent(valid),
+		NewResponseBuilder().CanFulfillSlots(nil),
+		NewResponseBuilder().CanFulfillIntent(valid).CanFulfillIntent(valid),
+		NewResponseBuilder().CanFulfillIntent(CanFulfillIntent{}),
+		NewResponseBuilder().CanFulfillIntent(CanFulfillIntent{CanFulfill: CanFulfillMaybe, Slots: map[string]CanFulfillSlot{
+			"drink": {CanUnderstand: CanFulfillYes, CanFulfill: CanFulfillMaybe},
+		}}),
+		NewResponseBuilder().CanFulfillIntent(CanFulfillIntent{CanFulfill: CanFulfillMaybe, Slots: map[string]CanFulfillSlot{
+			"drink": {CanFulfill: CanFulfillYes},
+		}}),
+	}
+
+	for i, b := range tests {
+		_, err := b.Build()
+		if _, ok := err.(*BuilderError); !ok {
+			t.Errorf("Expected *BuilderError for case %d, got %v", i, err)
+		}
+	}
+
+	_, err := NewResponseBuilder().ForRequest(newTestRequest(LaunchRequest, "")).CanFulfillIntent(valid).Build()
+	actual, expected := err.Error(), "alexado: invalid response: a response to LaunchRequest cannot carry canFulfillIntent"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
@@ -0,0 +1,234 @@
+package alexado
+
+// DialogDelegateDirective sends Alexa a command to handle the next turn in the dialog with the user, using the
+// prompts of the dialog model.
+type DialogDelegateDirective struct {
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"` // Intent with changed slot values or confirmation status
+}
+
+// DialogElicitSlotDirective sends Alexa a command to ask the user for the value of a specific slot.
+type DialogElicitSlotDirective struct {
+	SlotToElicit  string  `json:"slotToElicit"`            // Name of the slot to ask the user for
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"` // Intent with changed slot values or confirmation status
+}
+
+// DialogConfirmSlotDirective sends Alexa a command to confirm the value of a specific slot before continuing.
+type DialogConfirmSlotDirective struct {
+	SlotToConfirm string  `json:"slotToConfirm"`           // Name of the slot to confirm
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"` // Intent with changed slot values or confirmation status
+}
+
+// DialogConfirmIntentDirective sends Alexa a command to confirm all the information collected for the intent before
+// fulfilling it.
+type DialogConfirmIntentDirective struct {
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"` // Intent with changed slot values or confirmation status
+}
+
+// DialogUpdateDynamicEntitiesDirective replaces or clears the dynamic entities of the session, used by entity
+// resolution in addition to the slot type values of the interaction model.
+type DialogUpdateDynamicEntitiesDirective struct {
+	UpdateBehavior UpdateBehaviorType  `json:"updateBehavior"`  // REPLACE or CLEAR
+	Types          []DynamicEntityType `json:"types,omitempty"` // Dynamic entities per slot type. Only used with REPLACE.
+}
+
+// DynamicEntityType holds the dynamic entities of a slot type.
+type DynamicEntityType struct {
+	Name   string               `json:"name"`   // Name of the slot type
+	Values []DynamicEntityValue `json:"values"` // Entities added to the slot type
+}
+
+// DynamicEntityValue is a dynamic entity with its ID and synonyms.
+type DynamicEntityValue struct {
+	ID   string            `json:"id"`
+	Name DynamicEntityName `json:"name"`
+}
+
+// DynamicEntityName is the canonical value of a dynamic entity and its synonyms.
+type DynamicEntityName struct {
+	Value    string   `json:"value"`
+	Synonyms []string `json:"synonyms,omitempty"`
+}
+
+// DirectiveType returns "Dialog.Delegate".
+func (d DialogDelegateDirective) DirectiveType() string {
+	return "Dialog.Delegate"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d DialogDelegateDirective) MarshalJSON() ([]byte, error) {
+	type plain DialogDelegateDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// DirectiveType returns "Dialog.ElicitSlot".
+func (d DialogElicitSlotDirective) DirectiveType() string {
+	return "Dialog.ElicitSlot"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d DialogElicitSlotDirective) MarshalJSON() ([]byte, error) {
+	type plain DialogElicitSlotDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// DirectiveType returns "Dialog.ConfirmSlot".
+func (d DialogConfirmSlotDirective) DirectiveType() string {
+	return "Dialog.ConfirmSlot"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d DialogConfirmSlotDirective) MarshalJSON() ([]byte, error) {
+	type plain DialogConfirmSlotDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// DirectiveType returns "Dialog.ConfirmIntent".
+func (d DialogConfirmIntentDirective) DirectiveType() string {
+	return "Dialog.ConfirmIntent"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d DialogConfirmIntentDirective) MarshalJSON() ([]byte, error) {
+	type plain DialogConfirmIntentDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// DirectiveType returns "Dialog.UpdateDynamicEntities".
+func (d DialogUpdateDynamicEntitiesDirective) DirectiveType() string {
+	return "Dialog.UpdateDynamicEntities"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d DialogUpdateDynamicEntitiesDirective) MarshalJSON() ([]byte, error) {
+	type plain DialogUpdateDynamicEntitiesDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// isDialogDirective reports whether d manages the dialog of an intent, and so is only allowed in answer to an
+// IntentRequest. Dialog.UpdateDynamicEntities is not one of them.
+func isDialogDirective(d ResponseDirective) bool {
+	switch d.(type) {
+	case DialogDelegateDirective, DialogElicitSlotDirective, DialogConfirmSlotDirective, DialogConfirmIntentDirective:
+		return true
+	}
+
+	return false
+}
+
+// DialogStateType is the state of the multi-turn dialog of an intent
+type DialogStateType int
+
+const (
+	// DialogStarted is sent on the first turn of the dialog
+	DialogStarted DialogStateType = iota + 1
+	// DialogInProgress is sent while slots are being filled or confirmed
+	DialogInProgress
+	// DialogCompleted is sent once all required slots are filled and confirmed
+	DialogCompleted
+)
+
+var dialogStateTypeNames = [...]string{
+	"",
+	"STARTED",
+	"IN_PROGRESS",
+	"COMPLETED",
+}
+
+func (d DialogStateType) String() string {
+	return enumString(dialogStateTypeNames[:], int(d))
+}
+
+// IsValid reports whether d is one of the DialogStateType values. The unset zero value is not valid.
+func (d DialogStateType) IsValid() bool {
+	return validEnum(dialogStateTypeNames[:], int(d))
+}
+
+// DialogStateTypeValues returns every DialogStateType value in declaration order.
+func DialogStateTypeValues() []DialogStateType {
+	values := make([]DialogStateType, len(dialogStateTypeNames)-1)
+	for i := range values {
+		values[i] = DialogStateType(i + 1)
+	}
+
+	return values
+}
+
+// ParseDialogStateType returns the DialogStateType whose string value is s.
+func ParseDialogStateType(s string) (DialogStateType, error) {
+	i, err := parseEnum("DialogStateType", dialogStateTypeNames[:], s)
+
+	return DialogStateType(i), err
+}
+
+// MarshalJSON encodes d as its string value.
+func (d DialogStateType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("DialogStateType", dialogStateTypeNames[:], int(d))
+}
+
+// UnmarshalJSON decodes d from its string value. Unknown values, an empty string or null leave d unset.
+func (d *DialogStateType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(dialogStateTypeNames[:], data)
+	*d = DialogStateType(i)
+
+	return err
+}
+
+// UpdateBehaviorType describes how a Dialog.UpdateDynamicEntities directive changes the dynamic entities
+type UpdateBehaviorType int
+
+const (
+	// ReplaceEntities replaces the dynamic entities of the session with the ones of the directive
+	ReplaceEntities UpdateBehaviorType = iota + 1
+	// ClearEntities removes all the dynamic entities of the session
+	ClearEntities
+)
+
+var updateBehaviorTypeNames = [...]string{
+	"",
+	"REPLACE",
+	"CLEAR",
+}
+
+func (u UpdateBehaviorType) String() string {
+	return enumString(updateBehaviorTypeNames[:], int(u))
+}
+
+// IsValid reports whether u is one of the UpdateBehaviorType values. The unset zero value is not valid.
+func (u UpdateBehaviorType) IsValid() bool {
+	return validEnum(updateBehaviorTypeNames[:], int(u))
+}
+
+// UpdateBehaviorTypeValues returns every UpdateBehaviorType value in declaration order.
+func UpdateBehaviorTypeValues() []UpdateBehaviorType {
+	values := make([]UpdateBehaviorType, len(updateBehaviorTypeNames)-1)
+	for i := range values {
+		values[i] = UpdateBehaviorType(i + 1)
+	}
+
+	return values
+}
+
+// ParseUpdateBehaviorType returns the UpdateBehaviorType whose string value is s.
+func ParseUpdateBehaviorType(s string) (UpdateBehaviorType, error) {
+	i, err := parseEnum("UpdateBehaviorType", updateBehaviorTypeNames[:], s)
+
+	return UpdateBehaviorType(i), err
+}
+
+// MarshalJSON encodes u as its string value.
+func (u UpdateBehaviorType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("UpdateBehaviorType", updateBehaviorTypeNames[:], int(u))
+}
+
+// UnmarshalJSON decodes u from its string value. Unknown values, an empty string or null leave u unset.
+func (u *UpdateBehaviorType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(updateBehaviorTypeNames[:], data)
+	*u = UpdateBehaviorType(i)
+
+	return err
+}
@@ -0,0 +1,135 @@
+package alexado
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDialogStateTypeString(t *testing.T) {
+	var actual, expected string
+
+	actual, expected = DialogStarted.String(), "STARTED"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = DialogInProgress.String(), "IN_PROGRESS"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = DialogCompleted.String(), "COMPLETED"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestDialogStateUnmarshalsCorrectly(t *testing.T) {
+	areq := decodeTestRequest(t, `{"type":"IntentRequest","dialogState":"IN_PROGRESS","intent":{"name":"OrderDrinkIntent"}}`)
+
+	var actual, expected interface{}
+
+	actual, expected = areq.Request.DialogState, DialogInProgress
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = areq.Body().(*IntentRequestBody).DialogState, DialogInProgress
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestDialogDirectivesToJSON(t *testing.T) {
+	updated := &Intent{
+		Name:               "OrderDrinkIntent",
+		ConfirmationStatus: None,
+		Slots:              map[string]Slot{"drink": {Name: "drink", Value: "latte"}, "size": {Name: "size"}},
+	}
+
+	res, err := NewResponseBuilder().
+		ForRequest(newTestRequest(IntentRequest, "OrderDrinkIntent")).
+		Speak("What size?").
+		DialogElicitSlot("size", updated).
+		Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	b, _ := json.Marshal(res.Response.Directives)
+	actual, expected := string(b), `[{"type":"Dialog.ElicitSlot","slotToElicit":"size","updatedIntent":{"name":"OrderDrinkIntent","confirmationStatus":"NONE","slots":{"drink":{"name":"drink","value":"latte"},"size":{"name":"size"}}}}]`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	tests := []struct {
+		directive ResponseDirective
+		expected  string
+	}{
+		{DialogDelegateDirective{}, `{"type":"Dialog.Delegate"}`},
+		{DialogConfirmSlotDirective{SlotToConfirm: "size"}, `{"type":"Dialog.ConfirmSlot","slotToConfirm":"size"}`},
+		{DialogConfirmIntentDirective{UpdatedIntent: &Intent{Name: "OrderDrinkIntent"}}, `{"type":"Dialog.ConfirmIntent","updatedIntent":{"name":"OrderDrinkIntent"}}`},
+		{DialogUpdateDynamicEntitiesDirective{UpdateBehavior: ClearEntities}, `{"type":"Dialog.UpdateDynamicEntities","updateBehavior":"CLEAR"}`},
+		{DialogUpdateDynamicEntitiesDirective{UpdateBehavior: ReplaceEntities, Types: []DynamicEntityType{{
+			Name:   "Drink",
+			Values: []DynamicEntityValue{{ID: "FLAT_WHITE", Name: DynamicEntityName{Value: "flat white", Synonyms: []string{"white"}}}},
+		}}}, `{"type":"Dialog.UpdateDynamicEntities","updateBehavior":"REPLACE","types":[{"name":"Drink","values":[{"id":"FLAT_WHITE","name":{"value":"flat white","synonyms":["white"]}}]}]}`},
+	}
+
+	for _, test := range tests {
+		b, _ := json.Marshal(test.directive)
+		if string(b) != test.expected {
+			t.Errorf("'%s' != '%s'", b, test.expected)
+		}
+	}
+}
+
+func TestResponseBuilderDialogDirectives(t *testing.T) {
+	intentRequest := newTestRequest(IntentRequest, "OrderDrinkIntent")
+
+	tests := []*ResponseBuilder{
+		NewResponseBuilder().ForRequest(intentRequest).DialogDelegate(nil),
+		NewResponseBuilder().ForRequest(intentRequest).Speak("Which drink?").DialogElicitSlot("drink", nil),
+		NewResponseBuilder().ForRequest(intentRequest).Speak("A latte?").DialogConfirmSlot("drink", &Intent{Slots: map[string]Slot{"drink": {}}}),
+		NewResponseBuilder().ForRequest(intentRequest).Speak("One latte?").DialogConfirmIntent(nil).EndSession(false),
+		NewResponseBuilder().ForRequest(newTestRequest(LaunchRequest, "")).DialogUpdateDynamicEntities(ClearEntities),
+		NewResponseBuilder().DialogDelegate(nil),
+	}
+
+	for i, b := range tests {
+		if _, err := b.Build(); err != nil {
+			t.Errorf("Unexpected error for case %d: %v", i, err)
+		}
+	}
+}
+
+func TestResponseBuilderReportsDialogConflicts(t *testing.T) {
+	intentRequest := newTestRequest(IntentRequest, "OrderDrinkIntent")
+
+	tests := []*ResponseBuilder{
+		NewResponseBuilder().ForRequest(newTestRequest(LaunchRequest, "")).DialogDelegate(nil),
+		NewResponseBuilder().ForRequest(newTestRequest(CanFulfillIntentRequest, "")).DialogConfirmIntent(nil),
+		NewResponseBuilder().ForRequest(intentRequest).Speak("Hold on").DialogDelegate(nil),
+		NewResponseBuilder().ForRequest(intentRequest).Reprompt("Hold on").DialogDelegate(nil),
+		NewResponseBuilder().ForRequest(intentRequest).DialogElicitSlot("", nil),
+		NewResponseBuilder().ForRequest(intentRequest).DialogElicitSlot("size", &Intent{Slots: map[string]Slot{"drink": {}}}),
+		NewResponseBuilder().ForRequest(intentRequest).DialogConfirmSlot("drink", nil).DialogConfirmIntent(nil),
+		NewResponseBuilder().ForRequest(intentRequest).DialogConfirmIntent(nil).EndSession(true),
+		NewResponseBuilder().AddDirective(DialogDelegateDirective{}).ForRequest(newTestRequest(SessionEndedRequest, "")),
+		NewResponseBuilder().DialogUpdateDynamicEntities(ReplaceEntities),
+		NewResponseBuilder().DialogUpdateDynamicEntities(ClearEntities, DynamicEntityType{Name: "Drink"}),
+	}
+
+	for i, b := range tests {
+		_, err := b.Build()
+		if _, ok := err.(*BuilderError); !ok {
+			t.Errorf("Expected *BuilderError for case %d, got %v", i, err)
+		}
+	}
+
+	_, err := NewResponseBuilder().ForRequest(newTestRequest(LaunchRequest, "")).DialogDelegate(nil).Build()
+	actual, expected := err.Error(), "alexado: invalid response: a response to LaunchRequest cannot carry Dialog.Delegate"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
@@ -0,0 +1,191 @@
+package alexado
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// EnumError is returned when a value does not match any value of an enumeration
+type EnumError struct {
+	Type  string // Name of the enumeration type, such as LocaleType
+	Value string // Value that was not recognized
+	Path  string // Location of the value in a decoded request, such as request.locale. Empty outside of requests.
+}
+
+func (e *EnumError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("alexado: unknown %s value %q at %s", e.Type, e.Value, e.Path)
+	}
+
+	return fmt.Sprintf("alexado: unknown %s value %q", e.Type, e.Value)
+}
+
+// The enumerations of this package reserve their zero value for "unset", which has the empty string as its name.
+// The helpers below work on the table of names of an enumeration, indexed by value.
+
+// enumString returns the name of i, or Unknown(i) when i is outside the enumeration so that a bogus value never panics.
+func enumString(names []string, i int) string {
+	if i < 0 || i >= len(names) {
+		return fmt.Sprintf("Unknown(%d)", i)
+	}
+
+	return names[i]
+}
+
+func validEnum(names []string, i int) bool {
+	return i > 0 && i < len(names)
+}
+
+// enumIndex returns the value whose name is s, or 0 when there is none.
+func enumIndex(names []string, s string) int {
+	for i := 1; i < len(names); i++ {
+		if names[i] == s {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func parseEnum(typ string, names []string, s string) (int, error) {
+	if i := enumIndex(names, s); i != 0 {
+		return i, nil
+	}
+
+	return 0, &EnumError{Type: typ, Value: s}
+}
+
+func marshalEnum(typ string, names []string, i int) ([]byte, error) {
+	if i != 0 && !validEnum(names, i) {
+		return nil, &EnumError{Type: typ, Value: fmt.Sprint(i)}
+	}
+
+	return json.Marshal(names[i])
+}
+
+// unmarshalEnum decodes a JSON string into a value of the enumeration. null, "" and values missing from names decode to
+// unset, so that a value Alexa adds later does not fail the decoding of the whole request.
+func unmarshalEnum(names []string, data []byte) (int, error) {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return 0, err
+	}
+
+	if s == nil {
+		return 0, nil
+	}
+
+	return enumIndex(names, *s), nil
+}
+
+type enumTable struct {
+	name  string
+	names []string
+}
+
+// decodedEnums lists the enumerations decoded from JSON, so that the unknown values of a request can be reported.
+var decodedEnums = map[reflect.Type]enumTable{
+	reflect.TypeOf(ShapeType(0)):              {"ShapeType", shapeTypeNames[:]},
+	reflect.TypeOf(TouchType(0)):              {"TouchType", touchTypeNames[:]},
+	reflect.TypeOf(KeyboardType(0)):           {"KeyboardType", keyboardTypeNames[:]},
+	reflect.TypeOf(ThemeType(0)):              {"ThemeType", themeTypeNames[:]},
+	reflect.TypeOf(PlayerActivityType(0)):     {"PlayerActivityType", playerActivityTypeNames[:]},
+	reflect.TypeOf(ConfirmationStatusType(0)): {"ConfirmationStatusType", confirmationStatusTypeNames[:]},
+	reflect.TypeOf(SourceType(0)):             {"SourceType", sourceTypeNames[:]},
+	reflect.TypeOf(RequestType(0)):            {"RequestType", requestTypeNames[:]},
+	reflect.TypeOf(AmazonIntentType(0)):       {"AmazonIntentType", amazonIntentTypeNames[:]},
+	reflect.TypeOf(LocaleType(0)):             {"LocaleType", localeTypeNames[:]},
+	reflect.TypeOf(OutputSpeechType(0)):       {"OutputSpeechType", outputSpeechTypeNames[:]},
+	reflect.TypeOf(PlayBehaviorType(0)):       {"PlayBehaviorType", playBehaviorTypeNames[:]},
+	reflect.TypeOf(CardType(0)):               {"CardType", cardTypeNames[:]},
+	reflect.TypeOf(ClearBehaviorType(0)):      {"ClearBehaviorType", clearBehaviorTypeNames[:]},
+	reflect.TypeOf(ResolutionStatusCode(0)):   {"ResolutionStatusCode", resolutionStatusCodeNames[:]},
+	reflect.TypeOf(TimePeriodType(0)):         {"TimePeriodType", timePeriodTypeNames[:]},
+	reflect.TypeOf(SlotValueType(0)):          {"SlotValueType", slotValueTypeNames[:]},
+	reflect.TypeOf(DialogStateType(0)):        {"DialogStateType", dialogStateTypeNames[:]},
+	reflect.TypeOf(UpdateBehaviorType(0)):     {"UpdateBehaviorType", updateBehaviorTypeNames[:]},
+	reflect.TypeOf(CanFulfillType(0)):         {"CanFulfillType", canFulfillTypeNames[:]},
+}
+
+// unknownEnumValues walks the generic JSON value v along type t and returns an *EnumError for every string that
+// decodes to unset because it is not one of the values of its enumeration.
+func unknownEnumValues(t reflect.Type, v interface{}, path string) []*EnumError {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if table, ok := decodedEnums[t]; ok {
+		if s, ok := v.(string); ok && s != "" && enumIndex(table.names, s) == 0 {
+			return []*EnumError{{Type: table.name, Value: s, Path: path}}
+		}
+
+		return nil
+	}
+
+	var errs []*EnumError
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+
+			switch {
+			case name == "-" || (field.PkgPath != "" && !field.Anonymous):
+				continue
+			case field.Anonymous && name == "":
+				errs = append(errs, unknownEnumValues(field.Type, v, path)...)
+				continue
+			case name == "":
+				name = field.Name
+			}
+
+			if value, ok := jsonMember(object, name); ok {
+				errs = append(errs, unknownEnumValues(field.Type, value, joinPath(path, name))...)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		values, _ := v.([]interface{})
+		for i, value := range values {
+			errs = append(errs, unknownEnumValues(t.Elem(), value, path+"["+strconv.Itoa(i)+"]")...)
+		}
+	case reflect.Map:
+		object, _ := v.(map[string]interface{})
+		for key, value := range object {
+			errs = append(errs, unknownEnumValues(t.Elem(), value, joinPath(path, key))...)
+		}
+	}
+
+	return errs
+}
+
+// jsonMember returns the member of object named name, matched case-insensitively like encoding/json does.
+func jsonMember(object map[string]interface{}, name string) (interface{}, bool) {
+	if value, ok := object[name]; ok {
+		return value, true
+	}
+
+	for key, value := range object {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
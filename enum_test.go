@@ -0,0 +1,287 @@
+package alexado
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseEnum(t *testing.T) {
+	locale, err := ParseLocaleType("pt-BR")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if locale != PtBr {
+		t.Errorf("'%s' != '%s'", locale, PtBr)
+	}
+
+	request, err := ParseRequestType("AudioPlayer.PlaybackFailed")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if request != AudioPlayerPlaybackFailed {
+		t.Errorf("'%s' != '%s'", request, AudioPlayerPlaybackFailed)
+	}
+
+	_, err = ParseLocaleType("xx-XX")
+	enumErr, ok := err.(*EnumError)
+	if !ok {
+		t.Fatalf("Expected *EnumError, got %v", err)
+	}
+
+	actual, expected := enumErr.Error(), `alexado: unknown LocaleType value "xx-XX"`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if _, err = ParseShapeType(""); err == nil {
+		t.Error("Expected an error parsing the empty string")
+	}
+}
+
+func TestEnumMarshalJSON(t *testing.T) {
+	var v struct {
+		Locale   LocaleType       `json:"locale"`
+		Speech   OutputSpeechType `json:"speech"`
+		Card     CardType         `json:"card"`
+		Keyboard []KeyboardType   `json:"keyboard"`
+		Unset    ThemeType        `json:"unset"`
+	}
+	v.Locale, v.Speech, v.Card, v.Keyboard = HiIn, PlainText, AskForPermissionsConsent, []KeyboardType{Direction}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	actual, expected := string(b), `{"locale":"hi-IN","speech":"PlainText","card":"AskForPermissionsConsent","keyboard":["DIRECTION"],"unset":""}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if _, err = json.Marshal(CardType(42)); err == nil {
+		t.Error("Expected an error marshalling an out of range value")
+	}
+}
+
+func TestEnumUnmarshalJSON(t *testing.T) {
+	var v struct {
+		Locale   LocaleType             `json:"locale"`
+		Status   ConfirmationStatusType `json:"status"`
+		Activity PlayerActivityType     `json:"activity"`
+		Touch    []TouchType            `json:"touch"`
+		Empty    SourceType             `json:"empty"`
+		Null     ThemeType              `json:"null"`
+	}
+
+	data := `{"locale":"es-US","status":"DENIED","activity":"BUFFER_UNDERRUN","touch":["SINGLE"],"empty":"","null":null}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var actual, expected interface{}
+
+	actual, expected = v.Locale, EsUs
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = v.Status, Denied
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = v.Activity, BufferUnderrun
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = v.Touch[0], Single
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = v.Empty, SourceType(0)
+	if actual != expected {
+		t.Errorf("'%v' != '%v'", actual, expected)
+	}
+
+	actual, expected = v.Null, ThemeType(0)
+	if actual != expected {
+		t.Errorf("'%v' != '%v'", actual, expected)
+	}
+}
+
+func TestEnumUnmarshalJSONUnknownValue(t *testing.T) {
+	locale := EnUs
+
+	if err := json.Unmarshal([]byte(`"xx-XX"`), &locale); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	actual, expected := locale, LocaleType(0)
+	if actual != expected {
+		t.Errorf("'%v' != '%v'", actual, expected)
+	}
+
+	if err := json.Unmarshal([]byte(`1`), &locale); err == nil {
+		t.Error("Expected an error decoding a number")
+	}
+}
+
+func TestAlexaRequestUnknownValues(t *testing.T) {
+	var req AlexaRequest
+
+	content := []byte(`{"context":{"Viewport":{"shape":"OVAL","touch":["SINGLE","PINCH"]}},"request":{"type":"LaunchRequest","locale":"xx-XX"}}`)
+	if err := json.Unmarshal(content, &req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if req.Request.Locale != LocaleType(0) {
+		t.Errorf("'%s' != ''", req.Request.Locale)
+	}
+
+	if req.Context.Viewport.Shape != ShapeType(0) {
+		t.Errorf("'%s' != ''", req.Context.Viewport.Shape)
+	}
+
+	actual, expected := fmt.Sprint(req.Context.Viewport.Touch), fmt.Sprint([]TouchType{Single, 0})
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	var errs []string
+	for _, err := range req.UnknownValues() {
+		errs = append(errs, err.Error())
+	}
+
+	actual, expected = strings.Join(errs, "\n"), `alexado: unknown ShapeType value "OVAL" at context.Viewport.shape`+"\n"+
+		`alexado: unknown TouchType value "PINCH" at context.Viewport.touch[1]`+"\n"+
+		`alexado: unknown LocaleType value "xx-XX" at request.locale`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	var known AlexaRequest
+	if err := json.Unmarshal([]byte(`{"request":{"type":"LaunchRequest","locale":""}}`), &known); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(known.UnknownValues()) != 0 {
+		t.Errorf("Expected no unknown values, got %v", known.UnknownValues())
+	}
+}
+
+func TestDecodedEnumsCoverUnmarshalers(t *testing.T) {
+	unmarshaler := reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+	for _, v := range []testEnum{
+		ShapeType(0), TouchType(0), KeyboardType(0), ThemeType(0), PlayerActivityType(0),
+		ConfirmationStatusType(0), SourceType(0), RequestType(0), AmazonIntentType(0), LocaleType(0),
+		OutputSpeechType(0), PlayBehaviorType(0), CardType(0), ClearBehaviorType(0),
+		ResolutionStatusCode(0), TimePeriodType(0), SlotValueType(0),
+		DialogStateType(0), UpdateBehaviorType(0), CanFulfillType(0),
+	} {
+		typ := reflect.TypeOf(v)
+		table, ok := decodedEnums[typ]
+		if !ok && reflect.PtrTo(typ).Implements(unmarshaler) {
+			t.Errorf("%s is decoded from JSON but missing from decodedEnums", typ)
+		}
+
+		if ok && table.name != typ.Name() {
+			t.Errorf("'%s' != '%s'", table.name, typ.Name())
+		}
+	}
+}
+
+type testEnum interface {
+	String() string
+	IsValid() bool
+}
+
+func TestEnumOutOfRange(t *testing.T) {
+	values := []testEnum{
+		ShapeType(42), TouchType(42), KeyboardType(42), ThemeType(42), PlayerActivityType(42),
+		ConfirmationStatusType(42), SourceType(42), RequestType(42), AmazonIntentType(42), LocaleType(42),
+		OutputSpeechType(42), PlayBehaviorType(42), CardType(42), ClearBehaviorType(42), ShouldEndSessionType(42),
+		ResolutionStatusCode(42), TimePeriodType(42), SlotValueType(42),
+		DialogStateType(42), UpdateBehaviorType(42), CanFulfillType(42), ViewportProfile(42), ViewportSizeGroup(42),
+		ViewportDPIGroup(42), ViewportOrientation(42), DialogAction(42),
+	}
+
+	for _, v := range values {
+		actual, expected := v.String(), "Unknown(42)"
+		if actual != expected {
+			t.Errorf("%T: '%s' != '%s'", v, actual, expected)
+		}
+
+		if v.IsValid() {
+			t.Errorf("%T: 42 should not be valid", v)
+		}
+	}
+
+	actual, expected := LocaleType(-1).String(), "Unknown(-1)"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if _, err := json.Marshal(ShouldEndSessionType(42)); err == nil {
+		t.Error("Expected an error marshalling an out of range ShouldEndSessionType")
+	}
+}
+
+func TestEnumUnset(t *testing.T) {
+	actual, expected := LocaleType(0).String(), ""
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if LocaleType(0).IsValid() {
+		t.Error("The unset LocaleType should not be valid")
+	}
+
+	if !SessionUnset.IsValid() {
+		t.Error("SessionUnset should be valid")
+	}
+}
+
+func TestEnumValues(t *testing.T) {
+	locales := LocaleTypeValues()
+
+	actual, expected := len(locales), len(localeTypeNames)-1
+	if actual != expected {
+		t.Errorf("'%d' != '%d'", actual, expected)
+	}
+
+	for _, l := range locales {
+		if !l.IsValid() {
+			t.Errorf("%d should be valid", l)
+		}
+
+		parsed, err := ParseLocaleType(l.String())
+		if err != nil || parsed != l {
+			t.Errorf("'%s' does not round trip: %v", l, err)
+		}
+	}
+
+	if locales[0] != DeDe || locales[len(locales)-1] != PtBr {
+		t.Errorf("Unexpected order: %v", locales)
+	}
+
+	actual, expected = len(ClearBehaviorTypeValues()), 2
+	if actual != expected {
+		t.Errorf("'%d' != '%d'", actual, expected)
+	}
+
+	actions := DialogActionValues()
+	if len(actions) != 5 || actions[0] != ElicitSlotAction || actions[4] != DeniedAction {
+		t.Errorf("Unexpected values: %v", actions)
+	}
+
+	sessions := ShouldEndSessionTypeValues()
+	if len(sessions) != 3 || sessions[0] != SessionUnset {
+		t.Errorf("Unexpected values: %v", sessions)
+	}
+}
@@ -0,0 +1,96 @@
+package alexado
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+// MaxRequestBodySize is the largest request body, in bytes, accepted by Handler
+const MaxRequestBodySize = 1 << 20
+
+// HandlerFunc handles an AlexaRequest and returns the AlexaResponse to be sent to the Alexa platform
+type HandlerFunc func(ctx context.Context, req AlexaRequest) (AlexaResponse, error)
+
+// Handler is an http.Handler that decodes requests from the Alexa platform, dispatches them to a HandlerFunc
+// and writes the resulting AlexaResponse.
+type Handler struct {
+	Handle   HandlerFunc // Handles every decoded request
+	Verifier *Verifier   // Verifies requests before they are decoded. Requests are not verified when nil.
+}
+
+// NewHandler returns a Handler dispatching requests to fn.
+func NewHandler(fn HandlerFunc) *Handler {
+	return &Handler{Handle: fn}
+}
+
+// ServeHTTP decodes the AlexaRequest in r, handles it and writes the AlexaResponse to w.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestBodySize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "unable to read request body")
+		return
+	}
+
+	if h.Verifier != nil {
+		if err := h.Verifier.Verify(r.Header, body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	var areq AlexaRequest
+	if err := json.Unmarshal(body, &areq); err != nil {
+		writeError(w, http.StatusBadRequest, "malformed request body")
+		return
+	}
+
+	ares, err := h.Handle(r.Context(), areq)
+	if err != nil {
+		status := errorStatus(err)
+		writeError(w, status, http.StatusText(status))
+		return
+	}
+
+	content, err := json.Marshal(ares)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
+
+// errorStatus maps an error returned by a HandlerFunc to an HTTP status code. Errors wrapped by middleware are
+// unwrapped.
+func errorStatus(err error) int {
+	var verr *VerificationError
+	var aerr *ApplicationIDError
+
+	switch {
+	case errors.As(err, &verr), errors.As(err, &aerr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	content, _ := json.Marshal(struct {
+		Error string `json:"error"`
+	}{message})
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	w.Write(content)
+}
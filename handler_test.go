@@ -0,0 +1,150 @@
+package alexado
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func serve(h http.Handler, method string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, "/", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w
+}
+
+func TestHandlerWritesResponse(t *testing.T) {
+	content, _ := ioutil.ReadFile("sample/request.json")
+	content = []byte(strings.Replace(string(content), `"False"`, "false", -1))
+
+	var received AlexaRequest
+	h := NewHandler(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		received = req
+
+		osp := OutputSpeech{Type: PlainText, Text: "Hello"}
+		return AlexaResponse{Version: "1.0", Response: Response{OutputSpeech: &osp}}, nil
+	})
+
+	w := serve(h, http.MethodPost, string(content))
+
+	var actual, expected interface{}
+
+	actual, expected = w.Code, http.StatusOK
+	if actual != expected {
+		t.Errorf("'%v' != '%v'", actual, expected)
+	}
+
+	actual, expected = w.Header().Get("Content-Type"), "application/json;charset=UTF-8"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = w.Body.String(), `{"version":"1.0","response":{"outputSpeech":{"type":"PlainText","text":"Hello"}}}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = received.Request.Intent.Name, "MyCustomIntent"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestHandlerRejectsMalformedBody(t *testing.T) {
+	called := false
+	h := NewHandler(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		called = true
+		return AlexaResponse{}, nil
+	})
+
+	w := serve(h, http.MethodPost, `{"version":`)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("'%d' != '%d'", w.Code, http.StatusBadRequest)
+	}
+
+	if !strings.Contains(w.Body.String(), `"error"`) {
+		t.Errorf("Expected error body, got %s", w.Body.String())
+	}
+
+	if called {
+		t.Error("Handler should not be called for malformed input")
+	}
+}
+
+func TestHandlerRejectsNonPost(t *testing.T) {
+	h := NewHandler(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		return AlexaResponse{}, nil
+	})
+
+	w := serve(h, http.MethodGet, "")
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("'%d' != '%d'", w.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHandlerReportsHandlerErrors(t *testing.T) {
+	h := NewHandler(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		return AlexaResponse{}, errors.New("database is down")
+	})
+
+	w := serve(h, http.MethodPost, `{"version":"1.0"}`)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("'%d' != '%d'", w.Code, http.StatusInternalServerError)
+	}
+
+	if strings.Contains(w.Body.String(), "database") {
+		t.Errorf("Internal error details should not be written, got %s", w.Body.String())
+	}
+}
+
+func TestHandlerReportsWrappedRequestErrors(t *testing.T) {
+	tests := []error{
+		&VerificationError{Reason: "missing Signature-256 header"},
+		fmt.Errorf("checking request: %w", &VerificationError{Reason: "missing Signature-256 header"}),
+		fmt.Errorf("checking request: %w", &ApplicationIDError{SystemApplicationID: "amzn1.ask.skill.2"}),
+	}
+
+	for _, test := range tests {
+		err := test
+		h := NewHandler(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+			return AlexaResponse{}, err
+		})
+
+		w := serve(h, http.MethodPost, `{"version":"1.0"}`)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%v: '%d' != '%d'", err, w.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestHandlerVerifiesRequests(t *testing.T) {
+	pki := newTestPKI(t, "echo-api.amazon.com")
+
+	h := NewHandler(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		return AlexaResponse{Version: "1.0"}, nil
+	})
+	h.Verifier = pki.verifier(testRequestTime)
+
+	w := serve(h, http.MethodPost, string(testSignedBody))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("'%d' != '%d'", w.Code, http.StatusBadRequest)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(testSignedBody)))
+	r.Header = signedHeader(pki.sign(t, testSignedBody))
+	rw := httptest.NewRecorder()
+	h.ServeHTTP(rw, r)
+
+	if rw.Code != http.StatusOK {
+		t.Errorf("'%d' != '%d'", rw.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,64 @@
+package alexado
+
+// SupportedInterfaces lists each interface that the device supports. For example, if supportedInterfaces includes AudioPlayer {},
+// then you know that the device supports streaming audio using the AudioPlayer interface.
+// Alexa sends most interfaces as empty objects, so an interface is supported whenever its field is not nil.
+type SupportedInterfaces struct {
+	AudioPlayer *AudioPlayerInterface `json:"AudioPlayer,omitempty"`             // Set when the device supports streaming audio using the AudioPlayer interface
+	Display     *DisplayInterface     `json:"Display,omitempty"`                 // Set when the device has a screen supporting Display templates
+	VideoApp    *VideoAppInterface    `json:"VideoApp,omitempty"`                // Set when the device can play video files using the VideoApp interface
+	APL         *APLInterface         `json:"Alexa.Presentation.APL,omitempty"`  // Set when the device can render APL documents
+	APLT        *APLTInterface        `json:"Alexa.Presentation.APLT,omitempty"` // Set when the device has a character display supporting APLT documents
+	Geolocation *GeolocationInterface `json:"Geolocation,omitempty"`             // Set when the device can share its location
+}
+
+// AudioPlayerInterface is present in SupportedInterfaces when the device can stream audio.
+type AudioPlayerInterface struct{}
+
+// DisplayInterface is present in SupportedInterfaces when the device has a screen supporting Display templates.
+type DisplayInterface struct {
+	TemplateVersion string `json:"templateVersion,omitempty"` // Version of the Display templates the device supports
+	MarkupVersion   string `json:"markupVersion,omitempty"`   // Version of the text markup the device supports
+}
+
+// VideoAppInterface is present in SupportedInterfaces when the device can play video files.
+type VideoAppInterface struct{}
+
+// APLTInterface is present in SupportedInterfaces when the device has a character display, such as the clock of the
+// Echo Dot with clock, rendering Alexa Presentation Language for Text documents.
+type APLTInterface struct {
+	Runtime APLRuntime `json:"runtime"`
+}
+
+// GeolocationInterface is present in SupportedInterfaces when the device can share its location with the skill.
+type GeolocationInterface struct{}
+
+// SupportsAudio reports whether the device sending a can stream audio using the AudioPlayer interface.
+func (a AlexaRequest) SupportsAudio() bool {
+	return a.Context.System.Device.SupportedInterfaces.AudioPlayer != nil
+}
+
+// SupportsDisplay reports whether the device sending a can render Display templates.
+func (a AlexaRequest) SupportsDisplay() bool {
+	return a.Context.System.Device.SupportedInterfaces.Display != nil
+}
+
+// SupportsVideo reports whether the device sending a can play video files using the VideoApp interface.
+func (a AlexaRequest) SupportsVideo() bool {
+	return a.Context.System.Device.SupportedInterfaces.VideoApp != nil
+}
+
+// SupportsAPL reports whether the device sending a can render APL documents.
+func (a AlexaRequest) SupportsAPL() bool {
+	return a.Context.System.Device.SupportedInterfaces.APL != nil
+}
+
+// SupportsAPLT reports whether the device sending a can render APLT documents on a character display.
+func (a AlexaRequest) SupportsAPLT() bool {
+	return a.Context.System.Device.SupportedInterfaces.APLT != nil
+}
+
+// SupportsGeolocation reports whether the device sending a can share its location.
+func (a AlexaRequest) SupportsGeolocation() bool {
+	return a.Context.System.Device.SupportedInterfaces.Geolocation != nil
+}
@@ -0,0 +1,74 @@
+package alexado
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSupportedInterfacesUnmarshalsCorrectly(t *testing.T) {
+	content := []byte(`{
+	  "AudioPlayer": {},
+	  "Display": {"templateVersion": "1.0", "markupVersion": "1.0"},
+	  "VideoApp": {},
+	  "Alexa.Presentation.APL": {"runtime": {"maxVersion": "1.1"}},
+	  "Alexa.Presentation.APLT": {"runtime": {"maxVersion": "1.0"}},
+	  "Geolocation": {}
+	}`)
+
+	var s SupportedInterfaces
+	if err := json.Unmarshal(content, &s); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if s.AudioPlayer == nil || s.VideoApp == nil || s.Geolocation == nil {
+		t.Error("Expected interfaces sent as empty objects to be set")
+	}
+
+	var actual, expected string
+
+	actual, expected = s.Display.TemplateVersion, "1.0"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = s.APL.Runtime.MaxVersion, "1.1"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = s.APLT.Runtime.MaxVersion, "1.0"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	b, _ := json.Marshal(SupportedInterfaces{AudioPlayer: &AudioPlayerInterface{}})
+	actual, expected = string(b), `{"AudioPlayer":{}}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestSupportsInterfaces(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      AlexaRequest
+		supports func(AlexaRequest) bool
+		expected bool
+	}{
+		{"audio on a screen device", readAPLUserEvent(t), AlexaRequest.SupportsAudio, true},
+		{"display on a screen device", readAPLUserEvent(t), AlexaRequest.SupportsDisplay, true},
+		{"video on a screen device", readAPLUserEvent(t), AlexaRequest.SupportsVideo, true},
+		{"APL on a screen device", readAPLUserEvent(t), AlexaRequest.SupportsAPL, true},
+		{"APLT on a screen device", readAPLUserEvent(t), AlexaRequest.SupportsAPLT, false},
+		{"geolocation on a screen device", readAPLUserEvent(t), AlexaRequest.SupportsGeolocation, false},
+		{"audio without interfaces", newTestRequest(LaunchRequest, ""), AlexaRequest.SupportsAudio, false},
+		{"display without interfaces", newTestRequest(LaunchRequest, ""), AlexaRequest.SupportsDisplay, false},
+		{"video without interfaces", newTestRequest(LaunchRequest, ""), AlexaRequest.SupportsVideo, false},
+	}
+
+	for _, test := range tests {
+		if actual := test.supports(test.req); actual != test.expected {
+			t.Errorf("%s: '%t' != '%t'", test.name, actual, test.expected)
+		}
+	}
+}
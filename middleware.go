@@ -0,0 +1,129 @@
+package alexado
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"time"
+)
+
+// Middleware wraps a HandlerFunc with behavior shared by every request, such as logging or panic recovery
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Chain wraps fn with middleware. The first middleware is the outermost one: it sees the request first and the
+// response last.
+func Chain(fn HandlerFunc, middleware ...Middleware) HandlerFunc {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		fn = middleware[i](fn)
+	}
+
+	return fn
+}
+
+// Interceptor hooks into request handling before and after the wrapped HandlerFunc runs. Nil hooks are skipped.
+type Interceptor struct {
+	// Before runs ahead of the handler and may modify the request. Returning an error skips the handler.
+	Before func(ctx context.Context, req *AlexaRequest) error
+	// After runs once the handler succeeds and may modify the response.
+	After func(ctx context.Context, req AlexaRequest, res *AlexaResponse) error
+	// Error receives any error returned by Before, the handler or After. It may recover by returning a nil error.
+	Error func(ctx context.Context, req AlexaRequest, err error) (AlexaResponse, error)
+}
+
+// Middleware returns the Middleware running the hooks of i around a HandlerFunc.
+func (i Interceptor) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+			res, err := i.handle(ctx, req, next)
+			if err != nil && i.Error != nil {
+				return i.Error(ctx, req, err)
+			}
+
+			return res, err
+		}
+	}
+}
+
+func (i Interceptor) handle(ctx context.Context, req AlexaRequest, next HandlerFunc) (AlexaResponse, error) {
+	if i.Before != nil {
+		if err := i.Before(ctx, &req); err != nil {
+			return AlexaResponse{}, err
+		}
+	}
+
+	res, err := next(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	if i.After != nil {
+		if err := i.After(ctx, req, &res); err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+// PanicError is returned by Recover when a handler panics
+type PanicError struct {
+	Value interface{} // Value passed to panic
+	Stack []byte      // Stack trace of the panicking goroutine
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("alexado: handler panicked: %v", e.Value)
+}
+
+// Recover returns Middleware turning panics raised by the handler into a *PanicError.
+func Recover() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, req AlexaRequest) (res AlexaResponse, err error) {
+			defer func() {
+				if v := recover(); v != nil {
+					res, err = AlexaResponse{}, &PanicError{Value: v, Stack: debug.Stack()}
+				}
+			}()
+
+			return next(ctx, req)
+		}
+	}
+}
+
+// DefaultLocale returns Middleware setting the locale of requests that do not carry one to l. A locale LocaleType does
+// not know also decodes to unset and is replaced; AlexaRequest.UnknownValues still reports it.
+func DefaultLocale(l LocaleType) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+			if req.Request.Locale == 0 {
+				req.Request.Locale = l
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+// Logger returns Middleware writing the type, intent, duration and outcome of every request to l.
+func Logger(l *log.Logger) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+
+			name := req.Request.Type
+			if req.Request.Intent.Name != "" {
+				name += " " + req.Request.Intent.Name
+			}
+
+			if err != nil {
+				l.Printf("%s %s failed after %s: %v", req.Request.RequestID, name, time.Since(start), err)
+			} else {
+				l.Printf("%s %s handled in %s", req.Request.RequestID, name, time.Since(start))
+			}
+
+			return res, err
+		}
+	}
+}
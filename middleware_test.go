@@ -0,0 +1,137 @@
+package alexado
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+)
+
+func TestChainOrder(t *testing.T) {
+	var calls []string
+
+	trace := func(name string) Middleware {
+		return func(next HandlerFunc) HandlerFunc {
+			return func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+				calls = append(calls, "before "+name)
+				res, err := next(ctx, req)
+				calls = append(calls, "after "+name)
+				return res, err
+			}
+		}
+	}
+
+	fn := Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		calls = append(calls, "handler")
+		return AlexaResponse{}, nil
+	}, trace("outer"), trace("inner"))
+
+	fn(context.Background(), AlexaRequest{})
+
+	actual, expected := strings.Join(calls, ", "), "before outer, before inner, handler, after inner, after outer"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestInterceptorHooks(t *testing.T) {
+	i := Interceptor{
+		Before: func(ctx context.Context, req *AlexaRequest) error {
+			req.Version = "intercepted"
+			return nil
+		},
+		After: func(ctx context.Context, req AlexaRequest, res *AlexaResponse) error {
+			res.Version = req.Version + " and handled"
+			return nil
+		},
+	}
+
+	fn := Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		return AlexaResponse{Version: req.Version}, nil
+	}, i.Middleware())
+
+	res, _ := fn(context.Background(), AlexaRequest{})
+
+	actual, expected := res.Version, "intercepted and handled"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestInterceptorErrorRecovers(t *testing.T) {
+	called := false
+	i := Interceptor{
+		Before: func(ctx context.Context, req *AlexaRequest) error {
+			return errors.New("rejected")
+		},
+		Error: func(ctx context.Context, req AlexaRequest, err error) (AlexaResponse, error) {
+			return AlexaResponse{Version: err.Error()}, nil
+		},
+	}
+
+	fn := Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		called = true
+		return AlexaResponse{}, nil
+	}, i.Middleware())
+
+	res, err := fn(context.Background(), AlexaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if called {
+		t.Error("Handler should be skipped when Before fails")
+	}
+
+	if res.Version != "rejected" {
+		t.Errorf("'%s' != '%s'", res.Version, "rejected")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	fn := Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		panic("boom")
+	}, Recover())
+
+	_, err := fn(context.Background(), AlexaRequest{})
+
+	panicErr, ok := err.(*PanicError)
+	if !ok {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+
+	if panicErr.Value != "boom" {
+		t.Errorf("'%v' != '%s'", panicErr.Value, "boom")
+	}
+}
+
+func TestDefaultLocale(t *testing.T) {
+	var locales []string
+	fn := Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		locales = append(locales, req.Request.Locale.String())
+		return AlexaResponse{}, nil
+	}, DefaultLocale(EnGb))
+
+	req := AlexaRequest{}
+	fn(context.Background(), req)
+	req.Request.Locale = FrFr
+	fn(context.Background(), req)
+
+	actual, expected := strings.Join(locales, ","), "en-GB,fr-FR"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	fn := Chain(respondWith("1.0"), Logger(log.New(&buf, "", 0)))
+
+	fn(context.Background(), newTestRequest(IntentRequest, "MyCustomIntent"))
+
+	if !strings.Contains(buf.String(), "IntentRequest MyCustomIntent handled") {
+		t.Errorf("Unexpected log output: %s", buf.String())
+	}
+}
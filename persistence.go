@@ -0,0 +1,247 @@
+package alexado
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// AttributesStore persists attributes per user across sessions
+type AttributesStore interface {
+	// Load returns the attributes saved for userID, or nil when there are none.
+	Load(ctx context.Context, userID string) (Attributes, error)
+	// Save replaces the attributes saved for userID with a.
+	Save(ctx context.Context, userID string, a Attributes) error
+	// Delete removes the attributes saved for userID.
+	Delete(ctx context.Context, userID string) error
+}
+
+// MemoryStore is an AttributesStore keeping attributes in memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	users map[string][]byte
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{users: make(map[string][]byte)}
+}
+
+// Load returns the attributes saved for userID.
+func (m *MemoryStore) Load(ctx context.Context, userID string) (Attributes, error) {
+	m.mu.RLock()
+	content, ok := m.users[userID]
+	m.mu.RUnlock()
+
+	if !ok {
+		return nil, nil
+	}
+
+	return decodeAttributes(content)
+}
+
+// Save replaces the attributes saved for userID with a.
+func (m *MemoryStore) Save(ctx context.Context, userID string, a Attributes) error {
+	content, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.users[userID] = content
+	m.mu.Unlock()
+
+	return nil
+}
+
+// Delete removes the attributes saved for userID.
+func (m *MemoryStore) Delete(ctx context.Context, userID string) error {
+	m.mu.Lock()
+	delete(m.users, userID)
+	m.mu.Unlock()
+
+	return nil
+}
+
+// FileStore is an AttributesStore keeping the attributes of each user in a JSON file of a local directory.
+// It is safe for concurrent use within a process.
+type FileStore struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+// NewFileStore returns a FileStore writing to dir, creating the directory when needed.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Load returns the attributes saved for userID.
+func (f *FileStore) Load(ctx context.Context, userID string) (Attributes, error) {
+	f.mu.RLock()
+	content, err := ioutil.ReadFile(f.path(userID))
+	f.mu.RUnlock()
+
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeAttributes(content)
+}
+
+// Save replaces the attributes saved for userID with a. The file is replaced atomically.
+func (f *FileStore) Save(ctx context.Context, userID string, a Attributes) error {
+	content, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tmp, err := ioutil.TempFile(f.dir, ".attributes-")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.path(userID))
+}
+
+// Delete removes the attributes saved for userID.
+func (f *FileStore) Delete(ctx context.Context, userID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	err := os.Remove(f.path(userID))
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+// path hashes userID, which can be up to 255 characters long, into a safe file name.
+func (f *FileStore) path(userID string) string {
+	sum := sha256.Sum256([]byte(userID))
+
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func decodeAttributes(content []byte) (Attributes, error) {
+	var a Attributes
+	if err := json.Unmarshal(content, &a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+type attributesKey struct{}
+
+// AttributesManager gives handlers access to the session and persistent attributes of a request
+type AttributesManager struct {
+	Session    Attributes // Session attributes of the request. Returned in the response unless the handler sets its own or clears them.
+	Persistent Attributes // Attributes saved for the user. Saved after the handler succeeds if they changed.
+}
+
+// ClearSession removes every session attribute, so that the response carries none of the attributes of the request.
+func (m *AttributesManager) ClearSession() {
+	m.Session = nil
+}
+
+// AttributesFromContext returns the AttributesManager installed by PersistentAttributes, or nil.
+func AttributesFromContext(ctx context.Context) *AttributesManager {
+	m, _ := ctx.Value(attributesKey{}).(*AttributesManager)
+
+	return m
+}
+
+// PersistentAttributes returns Middleware loading the attributes saved for the user making the request from store
+// and saving them once the handler succeeds. Handlers reach them through AttributesFromContext.
+// Users are identified by Session.User.UserID, or by Context.System.User.UserID for requests sent outside of a session.
+// Nothing is loaded or saved for requests that identify no user.
+func PersistentAttributes(store AttributesStore) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+			userID := req.Session.User.UserID
+			if userID == "" {
+				userID = req.Context.System.User.UserID
+			}
+
+			var persistent Attributes
+			if userID != "" {
+				a, err := store.Load(ctx, userID)
+				if err != nil {
+					return AlexaResponse{}, err
+				}
+				persistent = a
+			}
+
+			loaded, err := json.Marshal(persistent)
+			if err != nil {
+				return AlexaResponse{}, err
+			}
+
+			m := &AttributesManager{Session: copyAttributes(req.Session.Attributes), Persistent: persistent}
+
+			res, err := next(context.WithValue(ctx, attributesKey{}, m), req)
+			if err != nil {
+				return res, err
+			}
+
+			if res.SessionAttributes == nil && len(m.Session) > 0 {
+				res.SessionAttributes = m.Session
+			}
+
+			saved, err := json.Marshal(m.Persistent)
+			if err != nil {
+				return AlexaResponse{}, err
+			}
+
+			if userID != "" && !bytes.Equal(loaded, saved) {
+				if err := store.Save(ctx, userID, m.Persistent); err != nil {
+					return AlexaResponse{}, err
+				}
+			}
+
+			return res, nil
+		}
+	}
+}
+
+func copyAttributes(a Attributes) Attributes {
+	if a == nil {
+		return nil
+	}
+
+	c := make(Attributes, len(a))
+	for k, v := range a {
+		c[k] = v
+	}
+
+	return c
+}
@@ -0,0 +1,160 @@
+package alexado
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func testStore(t *testing.T, store AttributesStore) {
+	ctx := context.Background()
+
+	a, err := store.Load(ctx, "amzn1.ask.account.userid")
+	if err != nil || a != nil {
+		t.Errorf("Expected no attributes for unknown user, got %v (%v)", a, err)
+	}
+
+	var saved Attributes
+	saved.Set("count", 3)
+	saved.Set("note", testNote{Title: "Rain"})
+
+	if err := store.Save(ctx, "amzn1.ask.account.userid", saved); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err = store.Load(ctx, "amzn1.ask.account.userid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i, _ := a.GetInt("count")
+	if i != 3 {
+		t.Errorf("'%d' != '%d'", i, 3)
+	}
+
+	if err := store.Delete(ctx, "amzn1.ask.account.userid"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Delete(ctx, "amzn1.ask.account.userid"); err != nil {
+		t.Errorf("Expected deleting a missing user to succeed, got %v", err)
+	}
+
+	a, _ = store.Load(ctx, "amzn1.ask.account.userid")
+	if a != nil {
+		t.Errorf("Expected attributes to be deleted, got %v", a)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "alexado")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testStore(t, store)
+}
+
+func TestPersistentAttributesMiddleware(t *testing.T) {
+	store := NewMemoryStore()
+
+	fn := Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		m := AttributesFromContext(ctx)
+
+		visits, _ := m.Persistent.GetInt("visits")
+		m.Persistent.Set("visits", visits+1)
+		m.Session.Set("last", "launch")
+
+		return AlexaResponse{Version: "1.0"}, nil
+	}, PersistentAttributes(store))
+
+	req := AlexaRequest{}
+	req.Session.User.UserID = "amzn1.ask.account.userid"
+
+	fn(context.Background(), req)
+	res, err := fn(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, _ := store.Load(context.Background(), "amzn1.ask.account.userid")
+	visits, _ := a.GetInt("visits")
+	if visits != 2 {
+		t.Errorf("'%d' != '%d'", visits, 2)
+	}
+
+	last, _ := res.SessionAttributes.GetString("last")
+	if last != "launch" {
+		t.Errorf("'%s' != '%s'", last, "launch")
+	}
+}
+
+func TestPersistentAttributesClearSession(t *testing.T) {
+	fn := Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		AttributesFromContext(ctx).ClearSession()
+
+		return AlexaResponse{Version: "1.0"}, nil
+	}, PersistentAttributes(NewMemoryStore()))
+
+	req := AlexaRequest{}
+	req.Session.Attributes = Attributes{}
+	req.Session.Attributes.Set("last", "launch")
+
+	res, err := fn(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.SessionAttributes != nil {
+		t.Errorf("Expected no session attributes, got %v", res.SessionAttributes)
+	}
+
+	fn = Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		return AlexaResponse{Version: "1.0"}, nil
+	}, PersistentAttributes(NewMemoryStore()))
+
+	res, _ = fn(context.Background(), req)
+	last, _ := res.SessionAttributes.GetString("last")
+	if last != "launch" {
+		t.Errorf("'%s' != '%s'", last, "launch")
+	}
+}
+
+type countingStore struct {
+	*MemoryStore
+	saves int
+}
+
+func (c *countingStore) Save(ctx context.Context, userID string, a Attributes) error {
+	c.saves++
+	return c.MemoryStore.Save(ctx, userID, a)
+}
+
+func TestPersistentAttributesSavesOnlyChanges(t *testing.T) {
+	store := &countingStore{MemoryStore: NewMemoryStore()}
+
+	fn := Chain(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		AttributesFromContext(ctx).Persistent.GetInt("visits")
+		return AlexaResponse{}, nil
+	}, PersistentAttributes(store))
+
+	// AudioPlayer requests carry no session, so the user is read from the system
+	req := AlexaRequest{}
+	req.Context.System.User.UserID = "amzn1.ask.account.userid"
+
+	fn(context.Background(), req)
+	if store.saves != 0 {
+		t.Errorf("'%d' != '%d'", store.saves, 0)
+	}
+}
@@ -0,0 +1,150 @@
+package alexado
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultProgressiveResponseTimeout is the maximum time a progressive response is given to be accepted by Alexa
+const DefaultProgressiveResponseTimeout = 5 * time.Second
+
+// VoicePlayerSpeakDirective is sent through the Progressive Response API to have Alexa speak while the skill prepares
+// its full response.
+type VoicePlayerSpeakDirective struct {
+	Speech string `json:"speech"` // Plain text or SSML to speak
+}
+
+// DirectiveType returns "VoicePlayer.Speak".
+func (d VoicePlayerSpeakDirective) DirectiveType() string {
+	return "VoicePlayer.Speak"
+}
+
+// MarshalJSON encodes the directive along with its type.
+func (d VoicePlayerSpeakDirective) MarshalJSON() ([]byte, error) {
+	type plain VoicePlayerSpeakDirective
+
+	return marshalDirective(d.DirectiveType(), plain(d))
+}
+
+// ProgressiveResponseError is returned when a progressive response cannot be sent or is rejected by Alexa
+type ProgressiveResponseError struct {
+	Reason     string // Describes what failed
+	StatusCode int    // HTTP status returned by Alexa, if any
+	Err        error  // Underlying error, if any
+}
+
+func (e *ProgressiveResponseError) Error() string {
+	switch {
+	case e.Err != nil:
+		return fmt.Sprintf("alexado: progressive response failed: %s: %v", e.Reason, e.Err)
+	case e.StatusCode != 0:
+		return fmt.Sprintf("alexado: progressive response failed: %s (status %d)", e.Reason, e.StatusCode)
+	default:
+		return fmt.Sprintf("alexado: progressive response failed: %s", e.Reason)
+	}
+}
+
+// ProgressiveResponseClient sends progressive responses to the Progressive Response API found at
+// Context.System.APIEndpoint, authenticated with Context.System.APIAccessToken. Alexa speaks them while the skill
+// runs a long operation, and accepts up to five of them per request.
+type ProgressiveResponseClient struct {
+	Client  *http.Client  // Sends the requests. http.DefaultClient is used when nil.
+	Timeout time.Duration // Maximum time of each request. DefaultProgressiveResponseTimeout is used when zero.
+}
+
+// NewProgressiveResponseClient returns a ProgressiveResponseClient sending requests with http.DefaultClient.
+func NewProgressiveResponseClient() *ProgressiveResponseClient {
+	return &ProgressiveResponseClient{}
+}
+
+type progressiveResponse struct {
+	Header    progressiveResponseHeader `json:"header"`
+	Directive VoicePlayerSpeakDirective `json:"directive"`
+}
+
+type progressiveResponseHeader struct {
+	RequestID string `json:"requestId"`
+}
+
+// Speak has Alexa speak speech to the user while req is being processed. speech is plain text or SSML, which is
+// validated before being sent. Speak returns once Alexa accepted the directive, ctx is done or the timeout elapsed.
+func (p *ProgressiveResponseClient) Speak(ctx context.Context, req AlexaRequest, speech string) error {
+	if strings.TrimSpace(speech) == "" {
+		return &ProgressiveResponseError{Reason: "the speech is empty"}
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(speech), "<speak") {
+		if err := ValidateSSML(speech); err != nil {
+			return &ProgressiveResponseError{Reason: "invalid SSML", Err: err}
+		}
+	}
+
+	return p.Send(ctx, req, VoicePlayerSpeakDirective{Speech: speech})
+}
+
+// Send posts directive for req to the Progressive Response API.
+func (p *ProgressiveResponseClient) Send(ctx context.Context, req AlexaRequest, directive VoicePlayerSpeakDirective) error {
+	system := req.Context.System
+
+	switch {
+	case system.APIEndpoint == "":
+		return &ProgressiveResponseError{Reason: "the request carries no apiEndpoint"}
+	case system.APIAccessToken == "":
+		return &ProgressiveResponseError{Reason: "the request carries no apiAccessToken"}
+	case req.Request.RequestID == "":
+		return &ProgressiveResponseError{Reason: "the request carries no requestId"}
+	}
+
+	body, err := json.Marshal(progressiveResponse{
+		Header:    progressiveResponseHeader{RequestID: req.Request.RequestID},
+		Directive: directive,
+	})
+	if err != nil {
+		return &ProgressiveResponseError{Reason: "encoding the directive", Err: err}
+	}
+
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = DefaultProgressiveResponseTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	hreq, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(system.APIEndpoint, "/")+"/v1/directives", bytes.NewReader(body))
+	if err != nil {
+		return &ProgressiveResponseError{Reason: "creating the request", Err: err}
+	}
+	hreq = hreq.WithContext(ctx)
+	hreq.Header.Set("Authorization", "Bearer "+system.APIAccessToken)
+	hreq.Header.Set("Content-Type", "application/json")
+
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(hreq)
+	if err != nil {
+		return &ProgressiveResponseError{Reason: "sending the request", Err: err}
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		message, _ := ioutil.ReadAll(res.Body)
+		reason := "rejected by Alexa"
+		if len(message) > 0 {
+			reason += ": " + strings.TrimSpace(string(message))
+		}
+
+		return &ProgressiveResponseError{Reason: reason, StatusCode: res.StatusCode}
+	}
+
+	return nil
+}
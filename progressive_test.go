@@ -0,0 +1,128 @@
+package alexado
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestProgressiveRequest(endpoint string) AlexaRequest {
+	req := newTestRequest(IntentRequest, "OrderDrinkIntent")
+	req.Request.RequestID = "amzn1.echo-api.request.1"
+	req.Context.System.APIEndpoint = endpoint
+	req.Context.System.APIAccessToken = "token"
+
+	return req
+}
+
+func TestProgressiveResponseSpeak(t *testing.T) {
+	var method, path, authorization, contentType, body string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		method, path, body = r.Method, r.URL.Path, string(b)
+		authorization, contentType = r.Header.Get("Authorization"), r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	p := &ProgressiveResponseClient{Client: server.Client()}
+	if err := p.Speak(context.Background(), newTestProgressiveRequest(server.URL+"/"), "Looking up your order"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		actual, expected string
+	}{
+		{method, http.MethodPost},
+		{path, "/v1/directives"},
+		{authorization, "Bearer token"},
+		{contentType, "application/json"},
+		{body, `{"header":{"requestId":"amzn1.echo-api.request.1"},"directive":{"type":"VoicePlayer.Speak","speech":"Looking up your order"}}`},
+	}
+
+	for _, test := range tests {
+		if test.actual != test.expected {
+			t.Errorf("'%s' != '%s'", test.actual, test.expected)
+		}
+	}
+}
+
+func TestProgressiveResponseRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	p := &ProgressiveResponseClient{Client: server.Client()}
+	err := p.Speak(context.Background(), newTestProgressiveRequest(server.URL), "Looking up your order")
+
+	perr, ok := err.(*ProgressiveResponseError)
+	if !ok {
+		t.Fatalf("Expected *ProgressiveResponseError, got %v", err)
+	}
+
+	if perr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("'%d' != '%d'", perr.StatusCode, http.StatusUnauthorized)
+	}
+
+	actual, expected := err.Error(), "alexado: progressive response failed: rejected by Alexa: invalid token (status 401)"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestProgressiveResponseTimeoutAndCancellation(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	p := &ProgressiveResponseClient{Client: server.Client(), Timeout: 50 * time.Millisecond}
+	if _, ok := p.Speak(context.Background(), newTestProgressiveRequest(server.URL), "Still working").(*ProgressiveResponseError); !ok {
+		t.Error("Expected the request to time out")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p = &ProgressiveResponseClient{Client: server.Client()}
+	if _, ok := p.Speak(ctx, newTestProgressiveRequest(server.URL), "Still working").(*ProgressiveResponseError); !ok {
+		t.Error("Expected the request to be cancelled")
+	}
+}
+
+func TestProgressiveResponseReportsInvalidRequests(t *testing.T) {
+	noEndpoint := newTestProgressiveRequest("")
+	noToken := newTestProgressiveRequest("https://api.amazonalexa.com")
+	noToken.Context.System.APIAccessToken = ""
+	noID := newTestProgressiveRequest("https://api.amazonalexa.com")
+	noID.Request.RequestID = ""
+	valid := newTestProgressiveRequest("https://api.amazonalexa.com")
+
+	tests := []struct {
+		req    AlexaRequest
+		speech string
+	}{
+		{noEndpoint, "Still working"},
+		{noToken, "Still working"},
+		{noID, "Still working"},
+		{valid, " "},
+		{valid, "<speak><unknown>Still working</unknown></speak>"},
+	}
+
+	p := NewProgressiveResponseClient()
+	for i, test := range tests {
+		if _, ok := p.Speak(context.Background(), test.req, test.speech).(*ProgressiveResponseError); !ok {
+			t.Errorf("Expected *ProgressiveResponseError for case %d", i)
+		}
+	}
+}
@@ -1,7 +1,10 @@
 // Package alexado provides objects and basic behavior for the sending requests to and processing responses for Alexa
 package alexado
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // AlexaRequest is expected request be sent from the Alexa plaform.
 type AlexaRequest struct {
@@ -9,6 +12,9 @@ type AlexaRequest struct {
 	Session Session `json:"session"` // Provides additional context associated with the request
 	Context Context `json:"context"` // Provides your skill with information about the current state of the Alexa service and device at the time the request is sent to your service
 	Request Request `json:"request"` // Provides the details of the user's request
+
+	raw     json.RawMessage // request object as received
+	unknown []*EnumError    // values decoded to unset because their enumeration does not know them
 }
 
 // Session provides additional context associated with the request.
@@ -32,16 +38,16 @@ type Context struct {
 
 // Viewport describes the operating characteristics of the display device.
 type Viewport struct {
-	Experiences        []Experience `json:"experiences"`
-	Shape              string       `json:"shape"`              // Shape of the viewport. RECTANGLE or ROUND.
-	PixelWidth         int          `json:"pixelWidth"`         // Maximum viewport value
-	PixelHeight        int          `json:"pixelHeight"`        // Maximum viewport value
-	DPI                int          `json:"dpi"`                // Pixel density of the viewport
-	CurrentPixelWidth  int          `json:"currentpixelWidth"`  // Viewport width that is currently in use
-	CurrentPixelHeight int          `json:"currentpixelHeight"` // Viewport height that is currently in use
-	Theme              string       `json:"theme"`              // Basic color scheme in use. LIGHT or DARK.
-	Touch              []string     `json:"touch"`
-	Keyboard           []string     `json:"keyboard"`
+	Experiences        []Experience   `json:"experiences"`
+	Shape              ShapeType      `json:"shape"`              // Shape of the viewport. RECTANGLE or ROUND.
+	PixelWidth         int            `json:"pixelWidth"`         // Maximum viewport value
+	PixelHeight        int            `json:"pixelHeight"`        // Maximum viewport value
+	DPI                int            `json:"dpi"`                // Pixel density of the viewport
+	CurrentPixelWidth  int            `json:"currentpixelWidth"`  // Viewport width that is currently in use
+	CurrentPixelHeight int            `json:"currentpixelHeight"` // Viewport height that is currently in use
+	Theme              ThemeType      `json:"theme"`              // Basic color scheme in use. LIGHT or DARK.
+	Touch              []TouchType    `json:"touch"`
+	Keyboard           []KeyboardType `json:"keyboard"`
 }
 
 type Experience struct {
@@ -56,55 +62,207 @@ type ShapeType int
 
 const (
 	// Rectangle for device with rectangular viewport
-	Rectangle ShapeType = iota
+	Rectangle ShapeType = iota + 1
 	// Round is for device with round viewport
 	Round
 )
 
+var shapeTypeNames = [...]string{
+	"",
+	"RECTANGLE",
+	"ROUND",
+}
+
 func (s ShapeType) String() string {
-	return [...]string{
-		"RECTANGLE",
-		"ROUND",
-	}[s]
+	return enumString(shapeTypeNames[:], int(s))
+}
+
+// IsValid reports whether s is one of the ShapeType values. The unset zero value is not valid.
+func (s ShapeType) IsValid() bool {
+	return validEnum(shapeTypeNames[:], int(s))
+}
+
+// ShapeTypeValues returns every ShapeType value in declaration order.
+func ShapeTypeValues() []ShapeType {
+	values := make([]ShapeType, len(shapeTypeNames)-1)
+	for i := range values {
+		values[i] = ShapeType(i + 1)
+	}
+
+	return values
+}
+
+// ParseShapeType returns the ShapeType whose string value is s.
+func ParseShapeType(s string) (ShapeType, error) {
+	i, err := parseEnum("ShapeType", shapeTypeNames[:], s)
+
+	return ShapeType(i), err
+}
+
+// MarshalJSON encodes s as its string value.
+func (s ShapeType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("ShapeType", shapeTypeNames[:], int(s))
+}
+
+// UnmarshalJSON decodes s from its string value. Unknown values, an empty string or null leave s unset.
+func (s *ShapeType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(shapeTypeNames[:], data)
+	*s = ShapeType(i)
+
+	return err
 }
 
 // TouchType represents basic color scheme in use. LIGHT or DARK.
 type TouchType int
 
 const (
-	Single TouchType = iota
+	Single TouchType = iota + 1
 )
 
+var touchTypeNames = [...]string{
+	"",
+	"SINGLE",
+}
+
 func (t TouchType) String() string {
-	return [...]string{
-		"SINGLE",
-	}[t]
+	return enumString(touchTypeNames[:], int(t))
+}
+
+// IsValid reports whether t is one of the TouchType values. The unset zero value is not valid.
+func (t TouchType) IsValid() bool {
+	return validEnum(touchTypeNames[:], int(t))
+}
+
+// TouchTypeValues returns every TouchType value in declaration order.
+func TouchTypeValues() []TouchType {
+	values := make([]TouchType, len(touchTypeNames)-1)
+	for i := range values {
+		values[i] = TouchType(i + 1)
+	}
+
+	return values
+}
+
+// ParseTouchType returns the TouchType whose string value is s.
+func ParseTouchType(s string) (TouchType, error) {
+	i, err := parseEnum("TouchType", touchTypeNames[:], s)
+
+	return TouchType(i), err
+}
+
+// MarshalJSON encodes t as its string value.
+func (t TouchType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("TouchType", touchTypeNames[:], int(t))
+}
+
+// UnmarshalJSON decodes t from its string value. Unknown values, an empty string or null leave t unset.
+func (t *TouchType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(touchTypeNames[:], data)
+	*t = TouchType(i)
+
+	return err
 }
 
 type KeyboardType int
 
 const (
-	Direction KeyboardType = iota
+	Direction KeyboardType = iota + 1
 )
 
+var keyboardTypeNames = [...]string{
+	"",
+	"DIRECTION",
+}
+
 func (k KeyboardType) String() string {
-	return [...]string{
-		"DIRECTION",
-	}[k]
+	return enumString(keyboardTypeNames[:], int(k))
+}
+
+// IsValid reports whether k is one of the KeyboardType values. The unset zero value is not valid.
+func (k KeyboardType) IsValid() bool {
+	return validEnum(keyboardTypeNames[:], int(k))
+}
+
+// KeyboardTypeValues returns every KeyboardType value in declaration order.
+func KeyboardTypeValues() []KeyboardType {
+	values := make([]KeyboardType, len(keyboardTypeNames)-1)
+	for i := range values {
+		values[i] = KeyboardType(i + 1)
+	}
+
+	return values
+}
+
+// ParseKeyboardType returns the KeyboardType whose string value is s.
+func ParseKeyboardType(s string) (KeyboardType, error) {
+	i, err := parseEnum("KeyboardType", keyboardTypeNames[:], s)
+
+	return KeyboardType(i), err
+}
+
+// MarshalJSON encodes k as its string value.
+func (k KeyboardType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("KeyboardType", keyboardTypeNames[:], int(k))
+}
+
+// UnmarshalJSON decodes k from its string value. Unknown values, an empty string or null leave k unset.
+func (k *KeyboardType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(keyboardTypeNames[:], data)
+	*k = KeyboardType(i)
+
+	return err
 }
 
 type ThemeType int
 
 const (
-	Light ThemeType = iota
+	Light ThemeType = iota + 1
 	Dark
 )
 
+var themeTypeNames = [...]string{
+	"",
+	"LIGHT",
+	"DARK",
+}
+
 func (t ThemeType) String() string {
-	return [...]string{
-		"LIGHT",
-		"DARK",
-	}[t]
+	return enumString(themeTypeNames[:], int(t))
+}
+
+// IsValid reports whether t is one of the ThemeType values. The unset zero value is not valid.
+func (t ThemeType) IsValid() bool {
+	return validEnum(themeTypeNames[:], int(t))
+}
+
+// ThemeTypeValues returns every ThemeType value in declaration order.
+func ThemeTypeValues() []ThemeType {
+	values := make([]ThemeType, len(themeTypeNames)-1)
+	for i := range values {
+		values[i] = ThemeType(i + 1)
+	}
+
+	return values
+}
+
+// ParseThemeType returns the ThemeType whose string value is s.
+func ParseThemeType(s string) (ThemeType, error) {
+	i, err := parseEnum("ThemeType", themeTypeNames[:], s)
+
+	return ThemeType(i), err
+}
+
+// MarshalJSON encodes t as its string value.
+func (t ThemeType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("ThemeType", themeTypeNames[:], int(t))
+}
+
+// UnmarshalJSON decodes t from its string value. Unknown values, an empty string or null leave t unset.
+func (t *ThemeType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(themeTypeNames[:], data)
+	*t = ThemeType(i)
+
+	return err
 }
 
 // System object provides information about the current state of the Alexa service and the device interacting with your skill.
@@ -126,9 +284,9 @@ type Device struct {
 // AudioPlayer is included on all customer-initiated requests (such as requests made by voice or using a remote control),
 // but includes the details about the playback (token and offsetInMilliseconds) only when sent to a skill that was most recently playing audio.
 type AudioPlayer struct {
-	PlayerActivity       string `json:"playerActivity"`       // Indicates the last known state of audio playback
-	Token                string `json:"token"`                // Represents the audio stream described by this AudioPlayer object
-	OffsetInMilliseconds int    `json:"offsetInMilliseconds"` // Identifies a track's offset in milliseconds at the time the request was sent. This is 0 if the track is at the beginning.
+	PlayerActivity       PlayerActivityType `json:"playerActivity"`       // Indicates the last known state of audio playback
+	Token                string             `json:"token"`                // Represents the audio stream described by this AudioPlayer object
+	OffsetInMilliseconds int                `json:"offsetInMilliseconds"` // Identifies a track's offset in milliseconds at the time the request was sent. This is 0 if the track is at the beginning.
 }
 
 // PlayerActivityType indicates the last known state of audio playback
@@ -136,7 +294,7 @@ type PlayerActivityType int
 
 const (
 	// Idle implies nothing was playing, no enqueued items.
-	Idle PlayerActivityType = iota
+	Idle PlayerActivityType = iota + 1
 	// Paused implies stream was paused.
 	Paused
 	// Playing implies stream was playing.
@@ -149,21 +307,53 @@ const (
 	Stopped
 )
 
+var playerActivityTypeNames = [...]string{
+	"",
+	"IDLE",
+	"PAUSED",
+	"PLAYING",
+	"BUFFER_UNDERRUN",
+	"FINISHED",
+	"STOPPED",
+}
+
 func (p PlayerActivityType) String() string {
-	return [...]string{
-		"IDLE",
-		"PAUSED",
-		"PLAYING",
-		"BUFFER_UNDERRUN",
-		"FINISHED",
-		"STOPPED",
-	}[p]
+	return enumString(playerActivityTypeNames[:], int(p))
 }
 
-// SupportedInterfaces lists each interface that the device supports. For example, if supportedInterfaces includes AudioPlayer {},
-// then you know that the device supports streaming audio using the AudioPlayer interface.
-type SupportedInterfaces struct {
-	AudioPlayer AudioPlayer `json:"audioPlayer"` // Lets you know that the device supports streaming audio using the AudioPlayer interface
+// IsValid reports whether p is one of the PlayerActivityType values. The unset zero value is not valid.
+func (p PlayerActivityType) IsValid() bool {
+	return validEnum(playerActivityTypeNames[:], int(p))
+}
+
+// PlayerActivityTypeValues returns every PlayerActivityType value in declaration order.
+func PlayerActivityTypeValues() []PlayerActivityType {
+	values := make([]PlayerActivityType, len(playerActivityTypeNames)-1)
+	for i := range values {
+		values[i] = PlayerActivityType(i + 1)
+	}
+
+	return values
+}
+
+// ParsePlayerActivityType returns the PlayerActivityType whose string value is s.
+func ParsePlayerActivityType(s string) (PlayerActivityType, error) {
+	i, err := parseEnum("PlayerActivityType", playerActivityTypeNames[:], s)
+
+	return PlayerActivityType(i), err
+}
+
+// MarshalJSON encodes p as its string value.
+func (p PlayerActivityType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("PlayerActivityType", playerActivityTypeNames[:], int(p))
+}
+
+// UnmarshalJSON decodes p from its string value. Unknown values, an empty string or null leave p unset.
+func (p *PlayerActivityType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(playerActivityTypeNames[:], data)
+	*p = PlayerActivityType(i)
+
+	return err
 }
 
 // Application contains an application ID. This is used to verify that the request was intended for your service.
@@ -185,59 +375,156 @@ type Permissions struct {
 
 // Request provides the details of the user's request. There are several different request types available.
 type Request struct {
-	RequestID                  string    `json:"requestId"`
-	Timestamp                  time.Time `json:"timestamp"`
-	Locale                     string    `json:"locale"`
-	Intent                     Intent    `json:"intent"`
-	Type                       string    `json:"type"`
-	ShouldLinkResultBeReturned bool      `json:"shouldLinkResultBeReturned"`
+	RequestID                  string     `json:"requestId"`
+	Timestamp                  time.Time  `json:"timestamp"`
+	Locale                     LocaleType `json:"locale"` // Unset when the locale is not one of the LocaleType values
+	Intent                     Intent     `json:"intent"`
+	Type                       string     `json:"type"` // A string rather than a RequestType so that request types this package does not know still decode
+	ShouldLinkResultBeReturned bool       `json:"shouldLinkResultBeReturned"`
+
+	Token                string          `json:"token,omitempty"`                // AudioPlayer requests: opaque token of the stream the request is about
+	OffsetInMilliseconds int             `json:"offsetInMilliseconds,omitempty"` // AudioPlayer requests: offset of the stream when the request was sent
+	Error                *ErrorInfo      `json:"error,omitempty"`                // AudioPlayer.PlaybackFailed: describes the error that occurred
+	CurrentPlaybackState *PlaybackState  `json:"currentPlaybackState,omitempty"` // AudioPlayer.PlaybackFailed: state of playback when the error occurred
+	DialogState          DialogStateType `json:"dialogState,omitempty"`          // IntentRequest: state of the multi-turn dialog, when the intent has a dialog model
+}
+
+// ErrorInfo describes an error reported by the Alexa platform in a request.
+type ErrorInfo struct {
+	Type    string `json:"type"`    // Identifies the type of error, such as MEDIA_ERROR_UNKNOWN or MEDIA_ERROR_SERVICE_UNAVAILABLE
+	Message string `json:"message"` // Describes the error
+}
+
+// PlaybackState describes the state of audio playback at the time a request was sent.
+type PlaybackState struct {
+	Token                string             `json:"token"`                // Token of the stream that was playing
+	OffsetInMilliseconds int                `json:"offsetInMilliseconds"` // Offset of the stream when the request was sent
+	PlayerActivity       PlayerActivityType `json:"playerActivity"`       // Last known state of audio playback
 }
 
 // Intent represents what user wants.
 type Intent struct {
-	Name               string          `json:"name"`
-	ConfirmationStatus string          `json:"confirmationStatus"`
-	Slots              map[string]Slot `json:"slots"`
+	Name               string                 `json:"name"`
+	ConfirmationStatus ConfirmationStatusType `json:"confirmationStatus,omitempty"`
+	Slots              map[string]Slot        `json:"slots,omitempty"`
 }
 
 // Slot represents user defined variables
 type Slot struct {
-	Name               string `json:"name"`
-	Value              string `json:"value"`
-	ConfirmationStatus string `json:"confirmationStatus"`
-	Source             string `json:"source"`
+	Name               string                 `json:"name"`
+	Value              string                 `json:"value,omitempty"`
+	ConfirmationStatus ConfirmationStatusType `json:"confirmationStatus,omitempty"`
+	Source             SourceType             `json:"source,omitempty"`
+	Resolutions        *Resolutions           `json:"resolutions,omitempty"` // Results of entity resolution, when the slot type supports it
+	SlotValue          *SlotValue             `json:"slotValue,omitempty"`   // Value of the slot, a list for slots accepting multiple values
 }
 
-//ConfirmationStatus is an enumeration indicating whether the user has explicitly confirmed or denied the value of this slot.
+// ConfirmationStatusType is an enumeration indicating whether the user has explicitly confirmed or denied the value of this slot.
 type ConfirmationStatusType int
 
 const (
 	// None indicates user has neither confirmed or denied the value of the slot.
-	None ConfirmationStatusType = iota
+	None ConfirmationStatusType = iota + 1
 	// Confirmed indicates user confirmed the value of the slot.
 	Confirmed
 	// Denied indicates user denied the value of the slot.
 	Denied
 )
 
+var confirmationStatusTypeNames = [...]string{
+	"",
+	"NONE",
+	"CONFIRMED",
+	"DENIED",
+}
+
 func (c ConfirmationStatusType) String() string {
-	return [...]string{
-		"NONE",
-		"CONFIRMED",
-		"DENIED",
-	}[c]
+	return enumString(confirmationStatusTypeNames[:], int(c))
+}
+
+// IsValid reports whether c is one of the ConfirmationStatusType values. The unset zero value is not valid.
+func (c ConfirmationStatusType) IsValid() bool {
+	return validEnum(confirmationStatusTypeNames[:], int(c))
+}
+
+// ConfirmationStatusTypeValues returns every ConfirmationStatusType value in declaration order.
+func ConfirmationStatusTypeValues() []ConfirmationStatusType {
+	values := make([]ConfirmationStatusType, len(confirmationStatusTypeNames)-1)
+	for i := range values {
+		values[i] = ConfirmationStatusType(i + 1)
+	}
+
+	return values
+}
+
+// ParseConfirmationStatusType returns the ConfirmationStatusType whose string value is s.
+func ParseConfirmationStatusType(s string) (ConfirmationStatusType, error) {
+	i, err := parseEnum("ConfirmationStatusType", confirmationStatusTypeNames[:], s)
+
+	return ConfirmationStatusType(i), err
+}
+
+// MarshalJSON encodes c as its string value.
+func (c ConfirmationStatusType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("ConfirmationStatusType", confirmationStatusTypeNames[:], int(c))
+}
+
+// UnmarshalJSON decodes c from its string value. Unknown values, an empty string or null leave c unset.
+func (c *ConfirmationStatusType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(confirmationStatusTypeNames[:], data)
+	*c = ConfirmationStatusType(i)
+
+	return err
 }
 
 type SourceType int
 
 const (
-	UserSource SourceType = iota
+	UserSource SourceType = iota + 1
 )
 
+var sourceTypeNames = [...]string{
+	"",
+	"USER",
+}
+
 func (s SourceType) String() string {
-	return [...]string{
-		"USER",
-	}[s]
+	return enumString(sourceTypeNames[:], int(s))
+}
+
+// IsValid reports whether s is one of the SourceType values. The unset zero value is not valid.
+func (s SourceType) IsValid() bool {
+	return validEnum(sourceTypeNames[:], int(s))
+}
+
+// SourceTypeValues returns every SourceType value in declaration order.
+func SourceTypeValues() []SourceType {
+	values := make([]SourceType, len(sourceTypeNames)-1)
+	for i := range values {
+		values[i] = SourceType(i + 1)
+	}
+
+	return values
+}
+
+// ParseSourceType returns the SourceType whose string value is s.
+func ParseSourceType(s string) (SourceType, error) {
+	i, err := parseEnum("SourceType", sourceTypeNames[:], s)
+
+	return SourceType(i), err
+}
+
+// MarshalJSON encodes s as its string value.
+func (s SourceType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("SourceType", sourceTypeNames[:], int(s))
+}
+
+// UnmarshalJSON decodes s from its string value. Unknown values, an empty string or null leave s unset.
+func (s *SourceType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(sourceTypeNames[:], data)
+	*s = SourceType(i)
+
+	return err
 }
 
 // RequestType describes types of requests to expect from the Alexa Platform.
@@ -245,23 +532,97 @@ type RequestType int
 
 const (
 	// LaunchRequest represents that a user made a request to an Alexa skill, but did not provide a specific intent.
-	LaunchRequest RequestType = iota
+	LaunchRequest RequestType = iota + 1
 	// CanFulfillIntentRequest represents a request made to skill to query whether the skill can understand and fulfill the intent request with detected slots, before actually asking the skill to take action.
 	CanFulfillIntentRequest
 	// SessionEndedRequest represents a request made to an Alexa skill to notify that a session was ended.
 	SessionEndedRequest
 	// IntentRequest represents a request made to a skill based on what the user wants to do.
 	IntentRequest
+	// AudioPlayerPlaybackStarted is sent when Alexa begins playing the audio stream previously sent in a Play directive.
+	AudioPlayerPlaybackStarted
+	// AudioPlayerPlaybackFinished is sent when the stream Alexa is playing comes to an end on its own.
+	AudioPlayerPlaybackFinished
+	// AudioPlayerPlaybackStopped is sent when Alexa stops playing an audio stream in response to a voice request or an AudioPlayer directive.
+	AudioPlayerPlaybackStopped
+	// AudioPlayerPlaybackNearlyFinished is sent when the currently playing stream is nearly complete and the device is ready to receive a new stream.
+	AudioPlayerPlaybackNearlyFinished
+	// AudioPlayerPlaybackFailed is sent when Alexa encounters an error when attempting to play a stream.
+	AudioPlayerPlaybackFailed
+	// PlaybackControllerPlayCommandIssued is sent when the user presses the play button on a device.
+	PlaybackControllerPlayCommandIssued
+	// PlaybackControllerPauseCommandIssued is sent when the user presses the pause button on a device.
+	PlaybackControllerPauseCommandIssued
+	// PlaybackControllerNextCommandIssued is sent when the user presses the next button on a device.
+	PlaybackControllerNextCommandIssued
+	// PlaybackControllerPreviousCommandIssued is sent when the user presses the previous button on a device.
+	PlaybackControllerPreviousCommandIssued
+	// DisplayElementSelected is sent when the user selects an item on a Display template by voice or touch.
+	DisplayElementSelected
+	// ConnectionsResponse is sent with the result of a task, such as a purchase, the skill delegated to Alexa.
+	ConnectionsResponse
+	// APLUserEvent is sent when the user interacts with an APL document, such as pressing a button.
+	APLUserEvent
 )
 
+var requestTypeNames = [...]string{
+	"",
+	"LaunchRequest",
+	"CanFulfillIntentRequest",
+	"SessionEndedRequest",
+	"IntentRequest",
+	"AudioPlayer.PlaybackStarted",
+	"AudioPlayer.PlaybackFinished",
+	"AudioPlayer.PlaybackStopped",
+	"AudioPlayer.PlaybackNearlyFinished",
+	"AudioPlayer.PlaybackFailed",
+	"PlaybackController.PlayCommandIssued",
+	"PlaybackController.PauseCommandIssued",
+	"PlaybackController.NextCommandIssued",
+	"PlaybackController.PreviousCommandIssued",
+	"Display.ElementSelected",
+	"Connections.Response",
+	"Alexa.Presentation.APL.UserEvent",
+}
+
 // String returns request type as string.
 func (r RequestType) String() string {
-	return [...]string{
-		"LaunchRequest",
-		"CanFulfillIntentRequest",
-		"SessionEndedRequest",
-		"IntentRequest",
-	}[r]
+	return enumString(requestTypeNames[:], int(r))
+}
+
+// IsValid reports whether r is one of the RequestType values. The unset zero value is not valid.
+func (r RequestType) IsValid() bool {
+	return validEnum(requestTypeNames[:], int(r))
+}
+
+// RequestTypeValues returns every RequestType value in declaration order.
+func RequestTypeValues() []RequestType {
+	values := make([]RequestType, len(requestTypeNames)-1)
+	for i := range values {
+		values[i] = RequestType(i + 1)
+	}
+
+	return values
+}
+
+// ParseRequestType returns the RequestType whose string value is s.
+func ParseRequestType(s string) (RequestType, error) {
+	i, err := parseEnum("RequestType", requestTypeNames[:], s)
+
+	return RequestType(i), err
+}
+
+// MarshalJSON encodes r as its string value.
+func (r RequestType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("RequestType", requestTypeNames[:], int(r))
+}
+
+// UnmarshalJSON decodes r from its string value. Unknown values, an empty string or null leave r unset.
+func (r *RequestType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(requestTypeNames[:], data)
+	*r = RequestType(i)
+
+	return err
 }
 
 // IntentType is a higher level enumeration for Amazon built in intent types and custom intent type.
@@ -272,7 +633,7 @@ type AmazonIntentType IntentType
 
 const (
 	// AmazonCancelIntent lets the user cancel a transaction or task or lets the user completely exit the skill.
-	AmazonCancelIntent AmazonIntentType = iota
+	AmazonCancelIntent AmazonIntentType = iota + 1
 	// AmazonFallbackIntent provides a fallback for user utterances that do not match any of your skill's intents.
 	AmazonFallbackIntent
 	// AmazonHelpIntent provides help about how to use the skill.
@@ -307,27 +668,65 @@ const (
 	AmazonNoIntent
 )
 
+var amazonIntentTypeNames = [...]string{
+	"",
+	"AMAZON.CancelIntent",
+	"AMAZON.FallbackIntent",
+	"AMAZON.HelpIntent",
+	"AMAZON.LoopOffIntent",
+	"AMAZON.LoopOnIntent",
+	"AMAZON.PauseIntent",
+	"AMAZON.PreviousIntent",
+	"AMAZON.NextIntent",
+	"AMAZON.RepeatIntent",
+	"AMAZON.ResumeIntent",
+	"AMAZON.SelectIntent",
+	"AMAZON.ShuffleOffIntent",
+	"AMAZON.ShuffleOnIntent",
+	"AMAZON.StartOverIntent",
+	"AMAZON.StopIntent",
+	"AMAZON.YesIntent",
+	"AMAZON.NoIntent",
+}
+
 // String returns intent type as string.
 func (a AmazonIntentType) String() string {
-	return [...]string{
-		"AMAZON.CancelIntent",
-		"AMAZON.FallbackIntent",
-		"AMAZON.HelpIntent",
-		"AMAZON.LoopOffIntent",
-		"AMAZON.LoopOnIntent",
-		"AMAZON.PauseIntent",
-		"AMAZON.PreviousIntent",
-		"AMAZON.NextIntent",
-		"AMAZON.RepeatIntent",
-		"AMAZON.ResumeIntent",
-		"AMAZON.SelectIntent",
-		"AMAZON.ShuffleOffIntent",
-		"AMAZON.ShuffleOnIntent",
-		"AMAZON.StartOverIntent",
-		"AMAZON.StopIntent",
-		"AMAZON.YesIntent",
-		"AMAZON.NoIntent",
-	}[a]
+	return enumString(amazonIntentTypeNames[:], int(a))
+}
+
+// IsValid reports whether a is one of the AmazonIntentType values. The unset zero value is not valid.
+func (a AmazonIntentType) IsValid() bool {
+	return validEnum(amazonIntentTypeNames[:], int(a))
+}
+
+// AmazonIntentTypeValues returns every AmazonIntentType value in declaration order.
+func AmazonIntentTypeValues() []AmazonIntentType {
+	values := make([]AmazonIntentType, len(amazonIntentTypeNames)-1)
+	for i := range values {
+		values[i] = AmazonIntentType(i + 1)
+	}
+
+	return values
+}
+
+// ParseAmazonIntentType returns the AmazonIntentType whose string value is s.
+func ParseAmazonIntentType(s string) (AmazonIntentType, error) {
+	i, err := parseEnum("AmazonIntentType", amazonIntentTypeNames[:], s)
+
+	return AmazonIntentType(i), err
+}
+
+// MarshalJSON encodes a as its string value.
+func (a AmazonIntentType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("AmazonIntentType", amazonIntentTypeNames[:], int(a))
+}
+
+// UnmarshalJSON decodes a from its string value. Unknown values, an empty string or null leave a unset.
+func (a *AmazonIntentType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(amazonIntentTypeNames[:], data)
+	*a = AmazonIntentType(i)
+
+	return err
 }
 
 // LocaleType represents the list of locales that Alexa supports
@@ -335,7 +734,7 @@ type LocaleType int
 
 const (
 	// DeDe de-DE German (DE)
-	DeDe LocaleType = iota
+	DeDe LocaleType = iota + 1
 	// EnAu en-AU English (AU)
 	EnAu
 	// EnCa en-CA English (CA)
@@ -358,21 +757,74 @@ const (
 	ItIt
 	// JaJp ja-JP Japanese (JP)
 	JaJp
+	// ArSa ar-SA Arabic (SA)
+	ArSa
+	// EsUs es-US Spanish (US)
+	EsUs
+	// HiIn hi-IN Hindi (IN)
+	HiIn
+	// NlNl nl-NL Dutch (NL)
+	NlNl
+	// PtBr pt-BR Portuguese (BR)
+	PtBr
 )
 
+var localeTypeNames = [...]string{
+	"",
+	"de-DE",
+	"en-AU",
+	"en-CA",
+	"en-GB",
+	"en-IN",
+	"en-US",
+	"es-ES",
+	"es-MX",
+	"fr-CA",
+	"fr-FR",
+	"it-IT",
+	"ja-JP",
+	"ar-SA",
+	"es-US",
+	"hi-IN",
+	"nl-NL",
+	"pt-BR",
+}
+
 func (l LocaleType) String() string {
-	return [...]string{
-		"de-DE",
-		"en-AU",
-		"en-CA",
-		"en-GB",
-		"en-IN",
-		"en-US",
-		"es-ES",
-		"es-MX",
-		"fr-CA",
-		"fr-FR",
-		"it-IT",
-		"ja-JP",
-	}[l]
+	return enumString(localeTypeNames[:], int(l))
+}
+
+// IsValid reports whether l is one of the LocaleType values. The unset zero value is not valid.
+func (l LocaleType) IsValid() bool {
+	return validEnum(localeTypeNames[:], int(l))
+}
+
+// LocaleTypeValues returns every LocaleType value in declaration order.
+func LocaleTypeValues() []LocaleType {
+	values := make([]LocaleType, len(localeTypeNames)-1)
+	for i := range values {
+		values[i] = LocaleType(i + 1)
+	}
+
+	return values
+}
+
+// ParseLocaleType returns the LocaleType whose string value is s.
+func ParseLocaleType(s string) (LocaleType, error) {
+	i, err := parseEnum("LocaleType", localeTypeNames[:], s)
+
+	return LocaleType(i), err
+}
+
+// MarshalJSON encodes l as its string value.
+func (l LocaleType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("LocaleType", localeTypeNames[:], int(l))
+}
+
+// UnmarshalJSON decodes l from its string value. Unknown values, an empty string or null leave l unset.
+func (l *LocaleType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(localeTypeNames[:], data)
+	*l = LocaleType(i)
+
+	return err
 }
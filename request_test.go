@@ -137,6 +137,56 @@ func TestRequestTypeString(t *testing.T) {
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
+
+	actual, expected = AudioPlayerPlaybackStarted.String(), "AudioPlayer.PlaybackStarted"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = AudioPlayerPlaybackFinished.String(), "AudioPlayer.PlaybackFinished"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = AudioPlayerPlaybackStopped.String(), "AudioPlayer.PlaybackStopped"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = AudioPlayerPlaybackNearlyFinished.String(), "AudioPlayer.PlaybackNearlyFinished"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = AudioPlayerPlaybackFailed.String(), "AudioPlayer.PlaybackFailed"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = PlaybackControllerPlayCommandIssued.String(), "PlaybackController.PlayCommandIssued"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = PlaybackControllerPauseCommandIssued.String(), "PlaybackController.PauseCommandIssued"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = PlaybackControllerNextCommandIssued.String(), "PlaybackController.NextCommandIssued"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = PlaybackControllerPreviousCommandIssued.String(), "PlaybackController.PreviousCommandIssued"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = APLUserEvent.String(), "Alexa.Presentation.APL.UserEvent"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
 }
 
 func TestAmazonIntentTypeString(t *testing.T) {
@@ -387,12 +437,12 @@ func TestRequestUnmarshallsCorrectly(t *testing.T) {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
 
-	actual, expected = viewport.Shape, Rectangle.String()
+	actual, expected = viewport.Shape, Rectangle
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
 
-	actual, expected = viewport.Theme, Light.String()
+	actual, expected = viewport.Theme, Light
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
@@ -422,12 +472,12 @@ func TestRequestUnmarshallsCorrectly(t *testing.T) {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
 
-	actual, expected = viewport.Touch[0], Single.String()
+	actual, expected = viewport.Touch[0], Single
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
 
-	actual, expected = viewport.Keyboard[0], Direction.String()
+	actual, expected = viewport.Keyboard[0], Direction
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
@@ -449,7 +499,7 @@ func TestRequestUnmarshallsCorrectly(t *testing.T) {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
 
-	actual, expected = request.Locale, EnUs.String()
+	actual, expected = request.Locale, EnUs
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
@@ -460,7 +510,7 @@ func TestRequestUnmarshallsCorrectly(t *testing.T) {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
 
-	actual, expected = intent.ConfirmationStatus, None.String()
+	actual, expected = intent.ConfirmationStatus, None
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
@@ -477,13 +527,65 @@ func TestRequestUnmarshallsCorrectly(t *testing.T) {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
 
-	actual, expected = speechSlot.ConfirmationStatus, "NONE"
+	actual, expected = speechSlot.ConfirmationStatus, None
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = speechSlot.Source, UserSource
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestPlaybackFailedRequestUnmarshallsCorrectly(t *testing.T) {
+	content, _ := ioutil.ReadFile("sample/playback_failed.json")
+
+	var alexaRequest AlexaRequest
+	if err := json.Unmarshal(content, &alexaRequest); err != nil {
+		t.Fatal(err)
+	}
+
+	var actual, expected interface{}
+
+	request := alexaRequest.Request
+	actual, expected = request.Type, AudioPlayerPlaybackFailed.String()
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = request.Token, "episode-2"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = request.Error.Type, "MEDIA_ERROR_SERVICE_UNAVAILABLE"
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
 
-	actual, expected = speechSlot.Source, "USER"
+	actual, expected = request.Error.Message, "The server could not be reached"
 	if actual != expected {
 		t.Errorf("'%s' != '%s'", actual, expected)
 	}
+
+	actual, expected = request.CurrentPlaybackState.Token, "episode-1"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = request.CurrentPlaybackState.OffsetInMilliseconds, 5000
+	if actual != expected {
+		t.Errorf("'%v' != '%v'", actual, expected)
+	}
+
+	actual, expected = request.CurrentPlaybackState.PlayerActivity, Playing
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = alexaRequest.Context.AudioPlayer.OffsetInMilliseconds, 5000
+	if actual != expected {
+		t.Errorf("'%v' != '%v'", actual, expected)
+	}
 }
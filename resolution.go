@@ -0,0 +1,190 @@
+package alexado
+
+import "strings"
+
+// DynamicAuthorityPrefix starts the authority of resolutions against dynamic entities.
+const DynamicAuthorityPrefix = "amzn1.er-authority.echo-sdk.dynamic."
+
+// Resolutions holds the results of entity resolution for a slot, one per authority the value was resolved against.
+type Resolutions struct {
+	ResolutionsPerAuthority []Resolution `json:"resolutionsPerAuthority"`
+}
+
+// Resolution is the result of entity resolution against a single authority, either the static slot type values
+// defined in the interaction model or the dynamic entities sent with a Dialog.UpdateDynamicEntities directive.
+type Resolution struct {
+	Authority string              `json:"authority"`        // Name of the authority, such as amzn1.er-authority.echo-sdk.<skill id>.<slot type>
+	Status    ResolutionStatus    `json:"status"`           // Outcome of entity resolution
+	Values    []ResolutionWrapper `json:"values,omitempty"` // Resolved values, best match first. Only set when the status is ER_SUCCESS_MATCH.
+}
+
+// ResolutionStatus is the outcome of entity resolution against an authority.
+type ResolutionStatus struct {
+	Code ResolutionStatusCode `json:"code"`
+}
+
+// ResolutionWrapper wraps a resolved value, as sent by Alexa.
+type ResolutionWrapper struct {
+	Value ResolutionValue `json:"value"`
+}
+
+// ResolutionValue is a canonical slot value the spoken value resolved to.
+type ResolutionValue struct {
+	Name string `json:"name"` // Canonical name of the value
+	ID   string `json:"id"`   // Unique ID of the value, as defined in the interaction model or dynamic entities
+}
+
+// IsDynamic reports whether r resolved against dynamic entities.
+func (r Resolution) IsDynamic() bool {
+	return strings.HasPrefix(r.Authority, DynamicAuthorityPrefix)
+}
+
+// IsMatch reports whether r resolved to at least one value.
+func (r Resolution) IsMatch() bool {
+	return r.Status.Code == ResolutionSuccessMatch && len(r.Values) > 0
+}
+
+// Match returns the resolution matching the spoken value. Dynamic entities take precedence over static ones, as they
+// do on the Alexa platform. ok is false when no authority matched.
+func (r *Resolutions) Match() (res Resolution, ok bool) {
+	if r == nil {
+		return Resolution{}, false
+	}
+
+	for _, res = range r.Dynamic() {
+		if res.IsMatch() {
+			return res, true
+		}
+	}
+
+	for _, res = range r.Static() {
+		if res.IsMatch() {
+			return res, true
+		}
+	}
+
+	return Resolution{}, false
+}
+
+// IsMatch reports whether any authority resolved the spoken value.
+func (r *Resolutions) IsMatch() bool {
+	_, ok := r.Match()
+
+	return ok
+}
+
+// ResolvedValue returns the best matching canonical value, or the zero value when there is no match.
+func (r *Resolutions) ResolvedValue() ResolutionValue {
+	res, ok := r.Match()
+	if !ok {
+		return ResolutionValue{}
+	}
+
+	return res.Values[0].Value
+}
+
+// Dynamic returns the resolutions against dynamic entities.
+func (r *Resolutions) Dynamic() []Resolution {
+	return r.filter(true)
+}
+
+// Static returns the resolutions against the slot type values of the interaction model.
+func (r *Resolutions) Static() []Resolution {
+	return r.filter(false)
+}
+
+func (r *Resolutions) filter(dynamic bool) []Resolution {
+	if r == nil {
+		return nil
+	}
+
+	var resolutions []Resolution
+	for _, res := range r.ResolutionsPerAuthority {
+		if res.IsDynamic() == dynamic {
+			resolutions = append(resolutions, res)
+		}
+	}
+
+	return resolutions
+}
+
+// IsMatch reports whether the value of s resolved to a canonical value.
+func (s Slot) IsMatch() bool {
+	return s.Resolutions.IsMatch()
+}
+
+// ResolvedID returns the ID of the canonical value s resolved to, or an empty string when it did not resolve.
+func (s Slot) ResolvedID() string {
+	return s.Resolutions.ResolvedValue().ID
+}
+
+// ResolvedValue returns the canonical name of the value s resolved to. It falls back to the spoken value when the
+// slot did not resolve, so that synonyms are only replaced when Alexa found a match.
+func (s Slot) ResolvedValue() string {
+	if v := s.Resolutions.ResolvedValue(); v.Name != "" {
+		return v.Name
+	}
+
+	return s.Value
+}
+
+// ResolutionStatusCode is the outcome of entity resolution against an authority.
+type ResolutionStatusCode int
+
+const (
+	// ResolutionSuccessMatch indicates the spoken value matched one or more values
+	ResolutionSuccessMatch ResolutionStatusCode = iota + 1
+	// ResolutionSuccessNoMatch indicates the spoken value did not match any value
+	ResolutionSuccessNoMatch
+	// ResolutionErrorTimeout indicates entity resolution timed out
+	ResolutionErrorTimeout
+	// ResolutionErrorException indicates entity resolution failed
+	ResolutionErrorException
+)
+
+var resolutionStatusCodeNames = [...]string{
+	"",
+	"ER_SUCCESS_MATCH",
+	"ER_SUCCESS_NO_MATCH",
+	"ER_ERROR_TIMEOUT",
+	"ER_ERROR_EXCEPTION",
+}
+
+func (c ResolutionStatusCode) String() string {
+	return enumString(resolutionStatusCodeNames[:], int(c))
+}
+
+// IsValid reports whether c is one of the ResolutionStatusCode values. The unset zero value is not valid.
+func (c ResolutionStatusCode) IsValid() bool {
+	return validEnum(resolutionStatusCodeNames[:], int(c))
+}
+
+// ResolutionStatusCodeValues returns every ResolutionStatusCode value in declaration order.
+func ResolutionStatusCodeValues() []ResolutionStatusCode {
+	values := make([]ResolutionStatusCode, len(resolutionStatusCodeNames)-1)
+	for i := range values {
+		values[i] = ResolutionStatusCode(i + 1)
+	}
+
+	return values
+}
+
+// ParseResolutionStatusCode returns the ResolutionStatusCode whose string value is s.
+func ParseResolutionStatusCode(s string) (ResolutionStatusCode, error) {
+	i, err := parseEnum("ResolutionStatusCode", resolutionStatusCodeNames[:], s)
+
+	return ResolutionStatusCode(i), err
+}
+
+// MarshalJSON encodes c as its string value.
+func (c ResolutionStatusCode) MarshalJSON() ([]byte, error) {
+	return marshalEnum("ResolutionStatusCode", resolutionStatusCodeNames[:], int(c))
+}
+
+// UnmarshalJSON decodes c from its string value. Unknown values, an empty string or null leave c unset.
+func (c *ResolutionStatusCode) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(resolutionStatusCodeNames[:], data)
+	*c = ResolutionStatusCode(i)
+
+	return err
+}
@@ -0,0 +1,161 @@
+package alexado
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func readEntityResolutionSlots(t *testing.T) map[string]Slot {
+	content, _ := ioutil.ReadFile("sample/entity_resolution.json")
+
+	var req AlexaRequest
+	if err := json.Unmarshal(content, &req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return req.Request.Intent.Slots
+}
+
+func TestResolutionStatusCodeString(t *testing.T) {
+	var actual, expected string
+
+	actual, expected = ResolutionSuccessMatch.String(), "ER_SUCCESS_MATCH"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = ResolutionSuccessNoMatch.String(), "ER_SUCCESS_NO_MATCH"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = ResolutionErrorTimeout.String(), "ER_ERROR_TIMEOUT"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = ResolutionErrorException.String(), "ER_ERROR_EXCEPTION"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestSlotResolutionsUnmarshalCorrectly(t *testing.T) {
+	slots := readEntityResolutionSlots(t)
+	resolutions := slots["drink"].Resolutions
+	if resolutions == nil {
+		t.Fatal("Expected resolutions on the drink slot")
+	}
+
+	var actual, expected interface{}
+
+	actual, expected = len(resolutions.ResolutionsPerAuthority), 2
+	if actual != expected {
+		t.Errorf("'%d' != '%d'", actual, expected)
+	}
+
+	static := resolutions.ResolutionsPerAuthority[0]
+
+	actual, expected = static.Status.Code, ResolutionSuccessMatch
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = static.Values[0].Value, ResolutionValue{Name: "latte", ID: "LATTE"}
+	if actual != expected {
+		t.Errorf("'%v' != '%v'", actual, expected)
+	}
+
+	actual, expected = slots["size"].Resolutions.ResolutionsPerAuthority[0].Status.Code, ResolutionSuccessNoMatch
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if slots["note"].Resolutions != nil {
+		t.Error("Expected no resolutions on the note slot")
+	}
+}
+
+func TestSlotResolvedValues(t *testing.T) {
+	slots := readEntityResolutionSlots(t)
+
+	tests := []struct {
+		slot    string
+		match   bool
+		id      string
+		value   string
+		dynamic int
+	}{
+		{"drink", true, "FLAT_WHITE", "flat white", 1},
+		{"milk", true, "OAT", "oat milk", 0},
+		{"size", false, "", "humongous", 0},
+		{"note", false, "", "", 0},
+		{"missing", false, "", "", 0},
+	}
+
+	for _, test := range tests {
+		slot := slots[test.slot]
+
+		if slot.IsMatch() != test.match {
+			t.Errorf("%s: IsMatch() != %t", test.slot, test.match)
+		}
+
+		if actual := slot.ResolvedID(); actual != test.id {
+			t.Errorf("%s: '%s' != '%s'", test.slot, actual, test.id)
+		}
+
+		if actual := slot.ResolvedValue(); actual != test.value {
+			t.Errorf("%s: '%s' != '%s'", test.slot, actual, test.value)
+		}
+
+		if actual := len(slot.Resolutions.Dynamic()); actual != test.dynamic {
+			t.Errorf("%s: '%d' != '%d'", test.slot, actual, test.dynamic)
+		}
+	}
+}
+
+func TestResolutionsMatchPrefersDynamicEntities(t *testing.T) {
+	drink := readEntityResolutionSlots(t)["drink"]
+
+	res, ok := drink.Resolutions.Match()
+	if !ok {
+		t.Fatal("Expected a match")
+	}
+
+	if !res.IsDynamic() {
+		t.Errorf("Expected the dynamic authority, got %s", res.Authority)
+	}
+
+	static := drink.Resolutions.Static()
+	if len(static) != 1 || static[0].IsDynamic() {
+		t.Errorf("Unexpected static resolutions: %v", static)
+	}
+
+	static[0].Status.Code = ResolutionErrorTimeout
+	resolutions := &Resolutions{ResolutionsPerAuthority: static}
+	if resolutions.IsMatch() {
+		t.Error("A timed out resolution should not match")
+	}
+}
+
+func TestSlotResolutionsToJSON(t *testing.T) {
+	slot := Slot{Name: "size", Value: "large"}
+
+	b, _ := json.Marshal(slot)
+	actual, expected := string(b), `{"name":"size","value":"large"}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	slot.Resolutions = &Resolutions{ResolutionsPerAuthority: []Resolution{{
+		Authority: "amzn1.er-authority.echo-sdk.skill.Size",
+		Status:    ResolutionStatus{Code: ResolutionSuccessNoMatch},
+	}}}
+
+	b, _ = json.Marshal(slot)
+	actual, expected = string(b), `{"name":"size","value":"large","resolutions":{"resolutionsPerAuthority":[{"authority":"amzn1.er-authority.echo-sdk.skill.Size","status":{"code":"ER_SUCCESS_NO_MATCH"}}]}}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
@@ -10,24 +10,91 @@ type AlexaResponse struct {
 	Response          Response   `json:"response,omitempty"`          // Defines what to render to the user and whether to end the current session
 }
 
-// Attributes are key value pairs
-type Attributes map[string]string
-
 // Response defines what to render to the user and whether to end the current session
 type Response struct {
-	OutputSpeech     *OutputSpeech `json:"outputSpeech,omitempty"`     // Contains the type of output speech to render
-	Card             *Card         `json:"card,omitempty"`             // Contains a card to render to the Amazon Alexa App
-	Reprompt         *Reprompt     `json:"reprompt,omitempty"`         // Contains the outputSpeech to use if a re-prompt is necessary
-	Directives       []Directive   `json:"directives,omitempty"`       // Specifies device-level actions to take using a particular interface, such as the AudioPlayer interface for streaming audio
-	ShouldEndSession bool          `json:"shouldEndSession,omitempty"` // True meaning that the session should end after Alexa speaks the response, or false if the session should remain active. If not provided, defaults to true.
+	OutputSpeech     *OutputSpeech        `json:"outputSpeech,omitempty"`     // Contains the type of output speech to render
+	Card             *Card                `json:"card,omitempty"`             // Contains a card to render to the Amazon Alexa App
+	Reprompt         *Reprompt            `json:"reprompt,omitempty"`         // Contains the outputSpeech to use if a re-prompt is necessary
+	Directives       []ResponseDirective  `json:"directives,omitempty"`       // Specifies device-level actions to take using a particular interface, such as the AudioPlayer interface for streaming audio
+	ShouldEndSession ShouldEndSessionType `json:"shouldEndSession,omitempty"` // True meaning that the session should end after Alexa speaks the response, or false if the session should remain active. If not provided, defaults to true.
+	CanFulfillIntent *CanFulfillIntent    `json:"canFulfillIntent,omitempty"` // Answers a CanFulfillIntentRequest: whether the skill can understand and fulfill the intent
+}
+
+// ShouldEndSessionType is the tri-state value of shouldEndSession: unset, true or false
+type ShouldEndSessionType int
+
+const (
+	// SessionUnset leaves shouldEndSession out of the response, as required for AudioPlayer and other interface responses
+	SessionUnset ShouldEndSessionType = iota
+	// SessionEnd ends the session after Alexa speaks the response
+	SessionEnd
+	// SessionKeepOpen keeps the session active after Alexa speaks the response
+	SessionKeepOpen
+)
+
+var shouldEndSessionTypeNames = [...]string{
+	"unset",
+	"true",
+	"false",
+}
+
+func (s ShouldEndSessionType) String() string {
+	return enumString(shouldEndSessionTypeNames[:], int(s))
+}
+
+// IsValid reports whether s is SessionUnset, SessionEnd or SessionKeepOpen.
+func (s ShouldEndSessionType) IsValid() bool {
+	return s >= 0 && int(s) < len(shouldEndSessionTypeNames)
+}
+
+// ShouldEndSessionTypeValues returns every ShouldEndSessionType value, SessionUnset included.
+func ShouldEndSessionTypeValues() []ShouldEndSessionType {
+	return []ShouldEndSessionType{SessionUnset, SessionEnd, SessionKeepOpen}
+}
+
+// Bool returns the value of shouldEndSession and whether it is set.
+func (s ShouldEndSessionType) Bool() (end bool, set bool) {
+	return s == SessionEnd, s != SessionUnset
+}
+
+// MarshalJSON encodes shouldEndSession as a JSON boolean, or null when unset.
+func (s ShouldEndSessionType) MarshalJSON() ([]byte, error) {
+	if !s.IsValid() {
+		return nil, &EnumError{Type: "ShouldEndSessionType", Value: s.String()}
+	}
+
+	if s == SessionUnset {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(s == SessionEnd)
+}
+
+// UnmarshalJSON decodes shouldEndSession from a JSON boolean or null.
+func (s *ShouldEndSessionType) UnmarshalJSON(data []byte) error {
+	var end *bool
+	if err := json.Unmarshal(data, &end); err != nil {
+		return err
+	}
+
+	switch {
+	case end == nil:
+		*s = SessionUnset
+	case *end:
+		*s = SessionEnd
+	default:
+		*s = SessionKeepOpen
+	}
+
+	return nil
 }
 
 // OutputSpeech is used for setting both the outputSpeech and the reprompt properties
 type OutputSpeech struct {
-	Type         string `json:"type,omitempty"`         // Contains the type of output speech to render
-	Text         string `json:"text,omitempty"`         // Contains the speech to render to the user
-	SSML         string `json:"ssml,omitempty"`         // Contains text marked up with SSML to render to the user. Use this when type is  "SSML"
-	PlayBehavior string `json:"playBehavior,omitempty"` // Determines the queuing and playback of this output speech
+	Type         OutputSpeechType `json:"type,omitempty"`         // Contains the type of output speech to render
+	Text         string           `json:"text,omitempty"`         // Contains the speech to render to the user
+	SSML         string           `json:"ssml,omitempty"`         // Contains text marked up with SSML to render to the user. Use this when type is  "SSML"
+	PlayBehavior PlayBehaviorType `json:"playBehavior,omitempty"` // Determines the queuing and playback of this output speech
 }
 
 // OutputSpeechType is the type of output speech to render
@@ -35,16 +102,54 @@ type OutputSpeechType int
 
 const (
 	// SSML indicates that the output speech is text marked up with SSML
-	SSML OutputSpeechType = iota
+	SSML OutputSpeechType = iota + 1
 	// PlainText indicates that the output speech is defined as plain text
 	PlainText
 )
 
+var outputSpeechTypeNames = [...]string{
+	"",
+	"SSML",
+	"PlainText",
+}
+
 func (o OutputSpeechType) String() string {
-	return [...]string{
-		"SSML",
-		"PlainText",
-	}[o]
+	return enumString(outputSpeechTypeNames[:], int(o))
+}
+
+// IsValid reports whether o is one of the OutputSpeechType values. The unset zero value is not valid.
+func (o OutputSpeechType) IsValid() bool {
+	return validEnum(outputSpeechTypeNames[:], int(o))
+}
+
+// OutputSpeechTypeValues returns every OutputSpeechType value in declaration order.
+func OutputSpeechTypeValues() []OutputSpeechType {
+	values := make([]OutputSpeechType, len(outputSpeechTypeNames)-1)
+	for i := range values {
+		values[i] = OutputSpeechType(i + 1)
+	}
+
+	return values
+}
+
+// ParseOutputSpeechType returns the OutputSpeechType whose string value is s.
+func ParseOutputSpeechType(s string) (OutputSpeechType, error) {
+	i, err := parseEnum("OutputSpeechType", outputSpeechTypeNames[:], s)
+
+	return OutputSpeechType(i), err
+}
+
+// MarshalJSON encodes o as its string value.
+func (o OutputSpeechType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("OutputSpeechType", outputSpeechTypeNames[:], int(o))
+}
+
+// UnmarshalJSON decodes o from its string value. Unknown values, an empty string or null leave o unset.
+func (o *OutputSpeechType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(outputSpeechTypeNames[:], data)
+	*o = OutputSpeechType(i)
+
+	return err
 }
 
 // PlayBehaviorType determines the queuing and playback of this output speech
@@ -52,28 +157,67 @@ type PlayBehaviorType int
 
 const (
 	// Enqueue adds this speech to the end of the queue. Do not interrupt Alexa's current speech. This is the default value for all skills that do not use the GameEngine interface
-	Enqueue PlayBehaviorType = iota
+	Enqueue PlayBehaviorType = iota + 1
 	// ReplaceAll immediately begins playback of this speech, and replace any current and enqueued speech. This is the default value for all skills that use the GameEngine interface
 	ReplaceAll
 	// ReplaceEnqueued replaces all speech in the queue with this speech. Do not interrupt Alexa's current speech
 	ReplaceEnqueued
 )
 
+var playBehaviorTypeNames = [...]string{
+	"",
+	"ENQUEUE",
+	"REPLACE_ALL",
+	"REPLACE_ENQUEUED",
+}
+
 func (p PlayBehaviorType) String() string {
-	return [...]string{
-		"ENQUEUE",
-		"REPLACE_ALL",
-		"REPLACE_ENQUEUED",
-	}[p]
+	return enumString(playBehaviorTypeNames[:], int(p))
+}
+
+// IsValid reports whether p is one of the PlayBehaviorType values. The unset zero value is not valid.
+func (p PlayBehaviorType) IsValid() bool {
+	return validEnum(playBehaviorTypeNames[:], int(p))
+}
+
+// PlayBehaviorTypeValues returns every PlayBehaviorType value in declaration order.
+func PlayBehaviorTypeValues() []PlayBehaviorType {
+	values := make([]PlayBehaviorType, len(playBehaviorTypeNames)-1)
+	for i := range values {
+		values[i] = PlayBehaviorType(i + 1)
+	}
+
+	return values
+}
+
+// ParsePlayBehaviorType returns the PlayBehaviorType whose string value is s.
+func ParsePlayBehaviorType(s string) (PlayBehaviorType, error) {
+	i, err := parseEnum("PlayBehaviorType", playBehaviorTypeNames[:], s)
+
+	return PlayBehaviorType(i), err
+}
+
+// MarshalJSON encodes p as its string value.
+func (p PlayBehaviorType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("PlayBehaviorType", playBehaviorTypeNames[:], int(p))
+}
+
+// UnmarshalJSON decodes p from its string value. Unknown values, an empty string or null leave p unset.
+func (p *PlayBehaviorType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(playBehaviorTypeNames[:], data)
+	*p = PlayBehaviorType(i)
+
+	return err
 }
 
 // Card can only be included when sending a response to a CanFulfillIntentRequest, LaunchRequest, IntentRequest, or InputHandlerEvent
 type Card struct {
-	Type    string `json:"type,omitempty"`    // Describes the type of card to render
-	Title   string `json:"title,omitempty"`   // Contains the title of the card. (not applicable for cards of type LinkAccount).
-	Text    string `json:"text,omitempty"`    // Contains the text content for a Standard card (not applicable for cards of type Simple or LinkAccount)
-	Content string `json:"content,omitempty"` // Contains the text content for a Standard card (not applicable for cards of type Simple or LinkAccount)
-	Image   *Image `json:"image,omitempty"`   // Specifies the URLs for the image to display on a Standard card. Only applicable for Standard cards.
+	Type        CardType `json:"type,omitempty"`        // Describes the type of card to render
+	Title       string   `json:"title,omitempty"`       // Contains the title of the card. (not applicable for cards of type LinkAccount).
+	Text        string   `json:"text,omitempty"`        // Contains the text content for a Standard card (not applicable for cards of type Simple or LinkAccount)
+	Content     string   `json:"content,omitempty"`     // Contains the text content for a Standard card (not applicable for cards of type Simple or LinkAccount)
+	Image       *Image   `json:"image,omitempty"`       // Specifies the URLs for the image to display on a Standard card. Only applicable for Standard cards.
+	Permissions []string `json:"permissions,omitempty"` // Lists the permissions the customer is asked to consent to. Only applicable for cards of type AskForPermissionsConsent.
 }
 
 // CardType describes the type of card to render
@@ -81,7 +225,7 @@ type CardType int
 
 const (
 	// Simple for when card contains a title and plain text content
-	Simple CardType = iota
+	Simple CardType = iota + 1
 	// Standard for when card contains a title, text content, and an image to display
 	Standard
 	// LinkAccount for when card displays a link to an authorization URI that the user can use to link their Alexa account with a user in another system
@@ -90,13 +234,51 @@ const (
 	AskForPermissionsConsent
 )
 
+var cardTypeNames = [...]string{
+	"",
+	"Simple",
+	"Standard",
+	"LinkAccount",
+	"AskForPermissionsConsent",
+}
+
 func (c CardType) String() string {
-	return [...]string{
-		"Simple",
-		"Standard",
-		"LinkAccount",
-		"AskForPermissionsConsent",
-	}[c]
+	return enumString(cardTypeNames[:], int(c))
+}
+
+// IsValid reports whether c is one of the CardType values. The unset zero value is not valid.
+func (c CardType) IsValid() bool {
+	return validEnum(cardTypeNames[:], int(c))
+}
+
+// CardTypeValues returns every CardType value in declaration order.
+func CardTypeValues() []CardType {
+	values := make([]CardType, len(cardTypeNames)-1)
+	for i := range values {
+		values[i] = CardType(i + 1)
+	}
+
+	return values
+}
+
+// ParseCardType returns the CardType whose string value is s.
+func ParseCardType(s string) (CardType, error) {
+	i, err := parseEnum("CardType", cardTypeNames[:], s)
+
+	return CardType(i), err
+}
+
+// MarshalJSON encodes c as its string value.
+func (c CardType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("CardType", cardTypeNames[:], int(c))
+}
+
+// UnmarshalJSON decodes c from its string value. Unknown values, an empty string or null leave c unset.
+func (c *CardType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(cardTypeNames[:], data)
+	*c = CardType(i)
+
+	return err
 }
 
 // Image specifies the URLs for the image to display on a Standard card. Only applicable for Standard cards.
@@ -110,11 +292,138 @@ type Reprompt struct {
 	OutputSpeech OutputSpeech `json:"outputSpeech,omitempty"`
 }
 
+// ResponseDirective is implemented by every directive that can be included in Response.Directives
+type ResponseDirective interface {
+	DirectiveType() string // Returns the value of the directive's type property
+}
+
 // Directive specifies device-level actions to take using a particular interface, such as the AudioPlayer interface for streaming audio
 type Directive struct {
 	Type string `json:"type,omitempty"`
 }
 
+// DirectiveType returns the type of the directive.
+func (d Directive) DirectiveType() string {
+	return d.Type
+}
+
+// UnknownDirective holds directives of a decoded response whose type this package does not model. It encodes back to
+// Raw, the directive as received.
+type UnknownDirective struct {
+	Type string          // Value of the directive's type property
+	Raw  json.RawMessage // Directive as received
+}
+
+// DirectiveType returns the type of the directive.
+func (d UnknownDirective) DirectiveType() string {
+	return d.Type
+}
+
+// MarshalJSON returns the directive as received.
+func (d UnknownDirective) MarshalJSON() ([]byte, error) {
+	return d.Raw, nil
+}
+
+// UnmarshalJSON decodes the response along with the concrete type of each directive, such as
+// AudioPlayerPlayDirective. Directives this package does not model are decoded as UnknownDirective.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	type plain Response
+
+	envelope := struct {
+		*plain
+		Directives []json.RawMessage `json:"directives,omitempty"`
+	}{plain: (*plain)(r)}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+
+	r.Directives = nil
+	for _, raw := range envelope.Directives {
+		d, err := decodeDirective(raw)
+		if err != nil {
+			return err
+		}
+
+		r.Directives = append(r.Directives, d)
+	}
+
+	return nil
+}
+
+func decodeDirective(raw json.RawMessage) (ResponseDirective, error) {
+	var header Directive
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	switch header.Type {
+	case "AudioPlayer.Play":
+		var d AudioPlayerPlayDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "AudioPlayer.Stop":
+		var d AudioPlayerStopDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "AudioPlayer.ClearQueue":
+		var d AudioPlayerClearQueueDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "Dialog.Delegate":
+		var d DialogDelegateDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "Dialog.ElicitSlot":
+		var d DialogElicitSlotDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "Dialog.ConfirmSlot":
+		var d DialogConfirmSlotDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "Dialog.ConfirmIntent":
+		var d DialogConfirmIntentDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "Dialog.UpdateDynamicEntities":
+		var d DialogUpdateDynamicEntitiesDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "Alexa.Presentation.APL.RenderDocument":
+		var d APLRenderDocumentDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	case "Alexa.Presentation.APL.ExecuteCommands":
+		var d APLExecuteCommandsDirective
+		err = json.Unmarshal(raw, &d)
+		return d, err
+	default:
+		return UnknownDirective{Type: header.Type, Raw: append(json.RawMessage(nil), raw...)}, nil
+	}
+}
+
+// marshalDirective encodes v, a struct without a type field, as a directive of the given type.
+func marshalDirective(directiveType string, v interface{}) ([]byte, error) {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := json.Marshal(directiveType)
+	if err != nil {
+		return nil, err
+	}
+
+	out := append([]byte(`{"type":`), typ...)
+	if len(content) > 2 {
+		out = append(out, ',')
+	}
+
+	return append(out, content[1:]...), nil
+}
+
 // ToJSON converts the AlexaResponse object to json format
 func (t AlexaResponse) ToJSON() (string, error) {
 	toJSON, err := json.Marshal(t)
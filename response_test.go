@@ -1,6 +1,9 @@
 package alexado
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestOutputSpeechTypeString(t *testing.T) {
 	var actual, expected string
@@ -60,11 +63,11 @@ func TestCardTypeString(t *testing.T) {
 }
 
 func TestToJSON(t *testing.T) {
-	var a Attributes = make(map[string]string)
-	a["context"] = "create"
-	a["object"] = "note"
+	var a Attributes
+	a.Set("context", "create")
+	a.Set("object", "note")
 
-	osp := OutputSpeech{Type: SSML.String(), SSML: "Some good speech."}
+	osp := OutputSpeech{Type: SSML, SSML: "Some good speech."}
 	res := Response{OutputSpeech: &osp}
 	req := AlexaResponse{Version: "1.0", Response: res, SessionAttributes: a}
 
@@ -76,3 +79,99 @@ func TestToJSON(t *testing.T) {
 		t.Errorf("JSON %s was not constructed properly.", actual)
 	}
 }
+
+func TestShouldEndSessionToJSON(t *testing.T) {
+	tests := []struct {
+		value    ShouldEndSessionType
+		expected string
+	}{
+		{SessionUnset, `{"version":"1.0","response":{}}`},
+		{SessionEnd, `{"version":"1.0","response":{"shouldEndSession":true}}`},
+		{SessionKeepOpen, `{"version":"1.0","response":{"shouldEndSession":false}}`},
+	}
+
+	for _, test := range tests {
+		res := AlexaResponse{Version: "1.0", Response: Response{ShouldEndSession: test.value}}
+
+		actual, _ := res.ToJSON()
+		if actual != test.expected {
+			t.Errorf("'%s' != '%s'", actual, test.expected)
+		}
+	}
+}
+
+func TestShouldEndSessionFromJSON(t *testing.T) {
+	tests := []struct {
+		content  string
+		expected ShouldEndSessionType
+	}{
+		{`{}`, SessionUnset},
+		{`{"shouldEndSession":null}`, SessionUnset},
+		{`{"shouldEndSession":true}`, SessionEnd},
+		{`{"shouldEndSession":false}`, SessionKeepOpen},
+	}
+
+	for _, test := range tests {
+		var res Response
+		if err := json.Unmarshal([]byte(test.content), &res); err != nil {
+			t.Fatal(err)
+		}
+
+		if res.ShouldEndSession != test.expected {
+			t.Errorf("'%s' != '%s'", res.ShouldEndSession, test.expected)
+		}
+	}
+
+	var res Response
+	if err := json.Unmarshal([]byte(`{"shouldEndSession":"yes"}`), &res); err == nil {
+		t.Error("Expected error for non boolean shouldEndSession")
+	}
+}
+
+func TestShouldEndSessionBool(t *testing.T) {
+	end, set := SessionKeepOpen.Bool()
+	if end || !set {
+		t.Errorf("Expected SessionKeepOpen to be set and false, got %t %t", end, set)
+	}
+
+	_, set = SessionUnset.Bool()
+	if set {
+		t.Error("Expected SessionUnset not to be set")
+	}
+}
+
+func TestResponseWithDirectivesFromJSON(t *testing.T) {
+	content := `{"version":"1.0","response":{"directives":[` +
+		`{"type":"AudioPlayer.Play","playBehavior":"REPLACE_ALL","audioItem":{"stream":{"url":"https://example.com/a.mp3","token":"a","offsetInMilliseconds":0}}},` +
+		`{"type":"Dialog.Delegate"},` +
+		`{"type":"Connections.SendRequest","name":"Buy","token":"t"}]}}`
+
+	var res AlexaResponse
+	if err := json.Unmarshal([]byte(content), &res); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	play, ok := res.Response.Directives[0].(AudioPlayerPlayDirective)
+	if !ok {
+		t.Fatalf("Expected AudioPlayerPlayDirective, got %T", res.Response.Directives[0])
+	}
+
+	if play.PlayBehavior != ReplaceAll || play.AudioItem.Stream.Token != "a" {
+		t.Errorf("Unexpected directive %+v", play)
+	}
+
+	if _, ok := res.Response.Directives[1].(DialogDelegateDirective); !ok {
+		t.Errorf("Expected DialogDelegateDirective, got %T", res.Response.Directives[1])
+	}
+
+	actual, expected := res.Response.Directives[2].DirectiveType(), "Connections.SendRequest"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	b, _ := json.Marshal(res)
+	actual, expected = string(b), content
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
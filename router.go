@@ -0,0 +1,84 @@
+package alexado
+
+import (
+	"context"
+	"fmt"
+)
+
+// Router dispatches requests to handlers registered per request type and per intent name.
+//
+// When several handlers match a request the most specific one is used: an IntentRequest is dispatched to the
+// handler registered for its intent name, then to the handler registered for IntentRequest and finally to the
+// fallback handler. Every other request is dispatched to the handler registered for its request type and then to
+// the fallback handler. Registering a handler for a request type or intent name that already has one replaces it.
+type Router struct {
+	requests map[string]HandlerFunc
+	intents  map[string]HandlerFunc
+	fallback HandlerFunc
+}
+
+// NewRouter returns a Router with no handlers registered.
+func NewRouter() *Router {
+	return &Router{
+		requests: make(map[string]HandlerFunc),
+		intents:  make(map[string]HandlerFunc),
+	}
+}
+
+// HandleRequest registers fn for requests of type t.
+func (r *Router) HandleRequest(t RequestType, fn HandlerFunc) {
+	r.requests[t.String()] = fn
+}
+
+// HandleIntent registers fn for IntentRequests carrying the intent called name.
+func (r *Router) HandleIntent(name string, fn HandlerFunc) {
+	r.intents[name] = fn
+}
+
+// HandleAmazonIntent registers fn for IntentRequests carrying the Amazon built in intent i.
+func (r *Router) HandleAmazonIntent(i AmazonIntentType, fn HandlerFunc) {
+	r.HandleIntent(i.String(), fn)
+}
+
+// HandleFallback registers fn for requests no other handler matches.
+func (r *Router) HandleFallback(fn HandlerFunc) {
+	r.fallback = fn
+}
+
+// Route dispatches req to the most specific matching handler. It can be used as the HandlerFunc of a Handler.
+func (r *Router) Route(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+	fn := r.match(req)
+	if fn == nil {
+		return AlexaResponse{}, &RouteError{Type: req.Request.Type, Intent: req.Request.Intent.Name}
+	}
+
+	return fn(ctx, req)
+}
+
+func (r *Router) match(req AlexaRequest) HandlerFunc {
+	if req.Request.Type == IntentRequest.String() {
+		if fn, ok := r.intents[req.Request.Intent.Name]; ok {
+			return fn
+		}
+	}
+
+	if fn, ok := r.requests[req.Request.Type]; ok {
+		return fn
+	}
+
+	return r.fallback
+}
+
+// RouteError is returned by Router when no handler matches a request
+type RouteError struct {
+	Type   string // Type of the unmatched request
+	Intent string // Intent name of the unmatched request, if any
+}
+
+func (e *RouteError) Error() string {
+	if e.Intent != "" {
+		return fmt.Sprintf("alexado: no handler for %s with intent %s", e.Type, e.Intent)
+	}
+
+	return fmt.Sprintf("alexado: no handler for %s", e.Type)
+}
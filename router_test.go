@@ -0,0 +1,79 @@
+package alexado
+
+import (
+	"context"
+	"testing"
+)
+
+func respondWith(text string) HandlerFunc {
+	return func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		return AlexaResponse{Version: text}, nil
+	}
+}
+
+func newTestRequest(t RequestType, intent string) AlexaRequest {
+	req := AlexaRequest{Version: "1.0"}
+	req.Request.Type = t.String()
+	req.Request.Intent.Name = intent
+
+	return req
+}
+
+func TestRouterPrecedence(t *testing.T) {
+	r := NewRouter()
+	r.HandleRequest(LaunchRequest, respondWith("launch"))
+	r.HandleRequest(IntentRequest, respondWith("intent"))
+	r.HandleIntent("MyCustomIntent", respondWith("custom"))
+	r.HandleAmazonIntent(AmazonHelpIntent, respondWith("help"))
+	r.HandleFallback(respondWith("fallback"))
+
+	tests := []struct {
+		req      AlexaRequest
+		expected string
+	}{
+		{newTestRequest(LaunchRequest, ""), "launch"},
+		{newTestRequest(IntentRequest, "MyCustomIntent"), "custom"},
+		{newTestRequest(IntentRequest, "AMAZON.HelpIntent"), "help"},
+		{newTestRequest(IntentRequest, "AMAZON.StopIntent"), "intent"},
+		{newTestRequest(SessionEndedRequest, ""), "fallback"},
+		{newTestRequest(CanFulfillIntentRequest, "MyCustomIntent"), "fallback"},
+	}
+
+	for _, test := range tests {
+		res, err := r.Route(context.Background(), test.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.Version != test.expected {
+			t.Errorf("'%s' != '%s'", res.Version, test.expected)
+		}
+	}
+}
+
+func TestRouterReplacesHandlers(t *testing.T) {
+	r := NewRouter()
+	r.HandleAmazonIntent(AmazonStopIntent, respondWith("first"))
+	r.HandleIntent("AMAZON.StopIntent", respondWith("second"))
+
+	res, _ := r.Route(context.Background(), newTestRequest(IntentRequest, "AMAZON.StopIntent"))
+	if res.Version != "second" {
+		t.Errorf("'%s' != '%s'", res.Version, "second")
+	}
+}
+
+func TestRouterReportsUnmatchedRequests(t *testing.T) {
+	r := NewRouter()
+	r.HandleRequest(LaunchRequest, respondWith("launch"))
+
+	_, err := r.Route(context.Background(), newTestRequest(IntentRequest, "MyCustomIntent"))
+
+	routeErr, ok := err.(*RouteError)
+	if !ok {
+		t.Fatalf("Expected *RouteError, got %v", err)
+	}
+
+	if routeErr.Intent != "MyCustomIntent" {
+		t.Errorf("'%s' != '%s'", routeErr.Intent, "MyCustomIntent")
+	}
+}
@@ -0,0 +1,249 @@
+package alexado
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Prompts holds the text of a prompt per locale. Prompts may reference slot values as {slotName}; they are replaced
+// by the resolved value of the slot.
+type Prompts map[LocaleType]string
+
+// DialogSlot declares how a slot of a Dialog is filled and confirmed.
+type DialogSlot struct {
+	Name     string          // Name of the slot
+	Required bool            // The dialog elicits the slot until it has a value
+	Confirm  bool            // The dialog asks the user to confirm the value of the slot
+	Validate func(Slot) bool // Optional. A value it rejects is elicited again with InvalidPrompts.
+
+	ElicitPrompts  Prompts // Asks the user for a value
+	ConfirmPrompts Prompts // Asks the user to confirm the value
+	InvalidPrompts Prompts // Asks the user for another value when Validate rejects one. Defaults to ElicitPrompts.
+}
+
+// Dialog fills the slots of an intent over several turns. It inspects the slot values and confirmation statuses of
+// the incoming intent and decides whether to elicit a slot, confirm a slot, confirm the intent or hand over to the
+// handler fulfilling the intent. Slots are processed in the order they are declared.
+type Dialog struct {
+	Slots          []DialogSlot
+	ConfirmIntent  bool       // The dialog asks the user to confirm the intent once all slots are filled
+	ConfirmPrompts Prompts    // Asks the user to confirm the intent
+	DeniedPrompts  Prompts    // Optional. Spoken before ending the session when the user denies the intent.
+	DefaultLocale  LocaleType // Locale of the prompts used when there are none for the locale of the request
+}
+
+// DialogStep is the next step of a Dialog.
+type DialogStep struct {
+	Action        DialogAction // What the dialog does next
+	Slot          string       // Slot to elicit or confirm
+	Prompt        string       // Text to speak, with slot values filled in
+	UpdatedIntent *Intent      // Intent sent back to Alexa. Rejected slot values are cleared from it.
+}
+
+// DialogError is returned when a Dialog cannot produce the next step
+type DialogError struct {
+	Intent string     // Name of the intent
+	Slot   string     // Name of the slot, if any
+	Locale LocaleType // Locale of the request
+	Reason string     // Describes the problem
+}
+
+func (e *DialogError) Error() string {
+	if e.Slot != "" {
+		return fmt.Sprintf("alexado: dialog of %s, slot %s: %s", e.Intent, e.Slot, e.Reason)
+	}
+
+	return fmt.Sprintf("alexado: dialog of %s: %s", e.Intent, e.Reason)
+}
+
+// Next returns the next step of the dialog for the intent of req.
+func (d Dialog) Next(req AlexaRequest) (DialogStep, error) {
+	intent := copyIntent(req.Request.Intent)
+	locale := req.Request.Locale
+
+	if intent.ConfirmationStatus == Denied {
+		if len(d.DeniedPrompts) == 0 {
+			return DialogStep{Action: DeniedAction}, nil
+		}
+
+		return d.step(DeniedAction, "", d.DeniedPrompts, intent, locale)
+	}
+
+	for _, ds := range d.Slots {
+		slot := intent.Slots[ds.Name]
+
+		if len(slot.Values()) == 0 {
+			if !ds.Required {
+				continue
+			}
+
+			return d.step(ElicitSlotAction, ds.Name, ds.ElicitPrompts, intent, locale)
+		}
+
+		if ds.Validate != nil && !ds.Validate(slot) {
+			prompts := ds.InvalidPrompts
+			if len(prompts) == 0 {
+				prompts = ds.ElicitPrompts
+			}
+
+			// The prompt is filled before clearing the slot so that it can repeat the rejected value.
+			step, err := d.step(ElicitSlotAction, ds.Name, prompts, intent, locale)
+			clearSlot(intent, ds.Name)
+
+			return step, err
+		}
+
+		if !ds.Confirm {
+			continue
+		}
+
+		switch slot.ConfirmationStatus {
+		case Confirmed:
+			continue
+		case Denied:
+			clearSlot(intent, ds.Name)
+
+			return d.step(ElicitSlotAction, ds.Name, ds.ElicitPrompts, intent, locale)
+		default:
+			return d.step(ConfirmSlotAction, ds.Name, ds.ConfirmPrompts, intent, locale)
+		}
+	}
+
+	if d.ConfirmIntent && intent.ConfirmationStatus != Confirmed {
+		return d.step(ConfirmIntentAction, "", d.ConfirmPrompts, intent, locale)
+	}
+
+	return DialogStep{Action: CompleteAction, UpdatedIntent: intent}, nil
+}
+
+func (d Dialog) step(action DialogAction, slot string, prompts Prompts, intent *Intent, locale LocaleType) (DialogStep, error) {
+	text, ok := prompts[locale]
+	if !ok {
+		text, ok = prompts[d.DefaultLocale]
+	}
+
+	if !ok {
+		return DialogStep{}, &DialogError{
+			Intent: intent.Name,
+			Slot:   slot,
+			Locale: locale,
+			Reason: fmt.Sprintf("no %s prompt for locale %q", action, locale),
+		}
+	}
+
+	return DialogStep{Action: action, Slot: slot, Prompt: fillPrompt(text, intent), UpdatedIntent: intent}, nil
+}
+
+// Response returns the response carrying out s for req. Steps completing the dialog, and denied steps without a
+// prompt, are left to the handler fulfilling the intent and return a *DialogError.
+func (s DialogStep) Response(req AlexaRequest) (AlexaResponse, error) {
+	b := NewResponseBuilder().ForRequest(req)
+
+	switch s.Action {
+	case ElicitSlotAction:
+		b.Speak(s.Prompt).Reprompt(s.Prompt).DialogElicitSlot(s.Slot, s.UpdatedIntent)
+	case ConfirmSlotAction:
+		b.Speak(s.Prompt).Reprompt(s.Prompt).DialogConfirmSlot(s.Slot, s.UpdatedIntent)
+	case ConfirmIntentAction:
+		b.Speak(s.Prompt).Reprompt(s.Prompt).DialogConfirmIntent(s.UpdatedIntent)
+	case DeniedAction:
+		if s.Prompt == "" {
+			return AlexaResponse{}, &DialogError{Intent: req.Request.Intent.Name, Reason: "no response for a denied intent"}
+		}
+
+		b.Speak(s.Prompt).EndSession(true)
+	default:
+		return AlexaResponse{}, &DialogError{Intent: req.Request.Intent.Name, Reason: "the dialog is " + s.Action.String()}
+	}
+
+	return b.Build()
+}
+
+// Handler returns a HandlerFunc running the dialog and calling complete once it is complete. complete is also called
+// when the user denies the intent and there are no DeniedPrompts, so that it can decide what to do.
+func (d Dialog) Handler(complete HandlerFunc) HandlerFunc {
+	return func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		step, err := d.Next(req)
+		if err != nil {
+			return AlexaResponse{}, err
+		}
+
+		if step.Action == CompleteAction || (step.Action == DeniedAction && step.Prompt == "") {
+			return complete(ctx, req)
+		}
+
+		return step.Response(req)
+	}
+}
+
+func copyIntent(intent Intent) *Intent {
+	slots := make(map[string]Slot, len(intent.Slots))
+	for name, slot := range intent.Slots {
+		slots[name] = slot
+	}
+	intent.Slots = slots
+
+	return &intent
+}
+
+func clearSlot(intent *Intent, name string) {
+	intent.Slots[name] = Slot{Name: name}
+}
+
+func fillPrompt(text string, intent *Intent) string {
+	if !strings.Contains(text, "{") {
+		return text
+	}
+
+	var replacements []string
+	for name, slot := range intent.Slots {
+		replacements = append(replacements, "{"+name+"}", slot.ResolvedValue())
+	}
+
+	return strings.NewReplacer(replacements...).Replace(text)
+}
+
+// DialogAction is what a Dialog does next
+type DialogAction int
+
+const (
+	// ElicitSlotAction asks the user for the value of a slot
+	ElicitSlotAction DialogAction = iota + 1
+	// ConfirmSlotAction asks the user to confirm the value of a slot
+	ConfirmSlotAction
+	// ConfirmIntentAction asks the user to confirm the intent
+	ConfirmIntentAction
+	// CompleteAction hands the filled intent over to the handler fulfilling it
+	CompleteAction
+	// DeniedAction follows the user denying the intent
+	DeniedAction
+)
+
+var dialogActionNames = [...]string{
+	"",
+	"elicit slot",
+	"confirm slot",
+	"confirm intent",
+	"complete",
+	"denied",
+}
+
+func (a DialogAction) String() string {
+	return enumString(dialogActionNames[:], int(a))
+}
+
+// IsValid reports whether a is one of the DialogAction values. The unset zero value is not valid.
+func (a DialogAction) IsValid() bool {
+	return validEnum(dialogActionNames[:], int(a))
+}
+
+// DialogActionValues returns every DialogAction value in declaration order.
+func DialogActionValues() []DialogAction {
+	values := make([]DialogAction, len(dialogActionNames)-1)
+	for i := range values {
+		values[i] = DialogAction(i + 1)
+	}
+
+	return values
+}
@@ -0,0 +1,192 @@
+package alexado
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func newTestDialog() Dialog {
+	return Dialog{
+		Slots: []DialogSlot{
+			{
+				Name:          "drink",
+				Required:      true,
+				ElicitPrompts: Prompts{EnUs: "What would you like to drink?", FrFr: "Que voulez-vous boire ?"},
+			},
+			{
+				Name:           "size",
+				Required:       true,
+				Confirm:        true,
+				Validate:       func(s Slot) bool { return s.Value == "small" || s.Value == "large" },
+				ElicitPrompts:  Prompts{EnUs: "What size?"},
+				ConfirmPrompts: Prompts{EnUs: "A {size} {drink}?"},
+				InvalidPrompts: Prompts{EnUs: "We have no {size} cups. Small or large?"},
+			},
+			{
+				Name:          "note",
+				ElicitPrompts: Prompts{EnUs: "Anything else?"},
+			},
+		},
+		ConfirmIntent:  true,
+		ConfirmPrompts: Prompts{EnUs: "Ordering a {size} {drink}. Is that right?"},
+		DefaultLocale:  EnUs,
+	}
+}
+
+func newTestDialogRequest(locale LocaleType, status ConfirmationStatusType, slots ...Slot) AlexaRequest {
+	req := newTestRequest(IntentRequest, "OrderDrinkIntent")
+	req.Request.Locale = locale
+	req.Request.Intent.ConfirmationStatus = status
+	req.Request.Intent.Slots = map[string]Slot{"drink": {Name: "drink"}, "size": {Name: "size"}, "note": {Name: "note"}}
+
+	for _, slot := range slots {
+		req.Request.Intent.Slots[slot.Name] = slot
+	}
+
+	return req
+}
+
+func TestDialogNext(t *testing.T) {
+	dialog := newTestDialog()
+
+	tests := []struct {
+		req    AlexaRequest
+		action DialogAction
+		slot   string
+		prompt string
+	}{
+		{newTestDialogRequest(EnUs, None), ElicitSlotAction, "drink", "What would you like to drink?"},
+		{newTestDialogRequest(FrFr, None), ElicitSlotAction, "drink", "Que voulez-vous boire ?"},
+		{newTestDialogRequest(EnGb, None), ElicitSlotAction, "drink", "What would you like to drink?"},
+		{newTestDialogRequest(EnUs, None, Slot{Name: "drink", Value: "tea"}), ElicitSlotAction, "size", "What size?"},
+		{newTestDialogRequest(EnUs, None, Slot{Name: "drink", Value: "tea"}, Slot{Name: "size", Value: "huge"}),
+			ElicitSlotAction, "size", "We have no huge cups. Small or large?"},
+		{newTestDialogRequest(EnUs, None, Slot{Name: "drink", Value: "tea"}, Slot{Name: "size", Value: "large", ConfirmationStatus: None}),
+			ConfirmSlotAction, "size", "A large tea?"},
+		{newTestDialogRequest(EnUs, None, Slot{Name: "drink", Value: "tea"}, Slot{Name: "size", Value: "large", ConfirmationStatus: Denied}),
+			ElicitSlotAction, "size", "What size?"},
+		{newTestDialogRequest(EnUs, None, Slot{Name: "drink", Value: "tea"}, Slot{Name: "size", Value: "large", ConfirmationStatus: Confirmed}),
+			ConfirmIntentAction, "", "Ordering a large tea. Is that right?"},
+		{newTestDialogRequest(EnUs, Confirmed, Slot{Name: "drink", Value: "tea"}, Slot{Name: "size", Value: "large", ConfirmationStatus: Confirmed}),
+			CompleteAction, "", ""},
+		{newTestDialogRequest(EnUs, Denied), DeniedAction, "", ""},
+	}
+
+	for i, test := range tests {
+		step, err := dialog.Next(test.req)
+		if err != nil {
+			t.Errorf("Unexpected error for case %d: %v", i, err)
+			continue
+		}
+
+		if step.Action != test.action || step.Slot != test.slot || step.Prompt != test.prompt {
+			t.Errorf("Case %d: '%s %s %s' != '%s %s %s'", i, step.Action, step.Slot, step.Prompt, test.action, test.slot, test.prompt)
+		}
+	}
+}
+
+func TestDialogNextClearsRejectedValues(t *testing.T) {
+	req := newTestDialogRequest(EnUs, None, Slot{Name: "drink", Value: "tea"}, Slot{Name: "size", Value: "huge", ConfirmationStatus: None})
+
+	step, _ := newTestDialog().Next(req)
+
+	var actual, expected interface{}
+
+	actual, expected = step.UpdatedIntent.Slots["size"], Slot{Name: "size"}
+	if actual != expected {
+		t.Errorf("'%v' != '%v'", actual, expected)
+	}
+
+	actual, expected = step.UpdatedIntent.Slots["drink"].Value, "tea"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = req.Request.Intent.Slots["size"].Value, "huge"
+	if actual != expected {
+		t.Errorf("The request should not be modified: '%s' != '%s'", actual, expected)
+	}
+}
+
+func TestDialogNextMissingPrompt(t *testing.T) {
+	dialog := newTestDialog()
+	dialog.DefaultLocale = 0
+
+	_, err := dialog.Next(newTestDialogRequest(EnGb, None))
+	dialogErr, ok := err.(*DialogError)
+	if !ok {
+		t.Fatalf("Expected *DialogError, got %v", err)
+	}
+
+	actual, expected := dialogErr.Error(), `alexado: dialog of OrderDrinkIntent, slot drink: no elicit slot prompt for locale "en-GB"`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestDialogStepResponse(t *testing.T) {
+	req := newTestDialogRequest(EnUs, None, Slot{Name: "drink", Value: "tea"})
+
+	step, _ := newTestDialog().Next(req)
+	res, err := step.Response(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	b, _ := json.Marshal(res.Response)
+	actual, expected := string(b), `{"outputSpeech":{"type":"PlainText","text":"What size?"},"reprompt":{"outputSpeech":{"type":"PlainText","text":"What size?"}},`+
+		`"directives":[{"type":"Dialog.ElicitSlot","slotToElicit":"size","updatedIntent":{"name":"OrderDrinkIntent","confirmationStatus":"NONE","slots":{"drink":{"name":"drink","value":"tea"},"note":{"name":"note"},"size":{"name":"size"}}}}]}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if _, err = (DialogStep{Action: CompleteAction}).Response(req); err == nil {
+		t.Error("Expected an error for a complete dialog")
+	}
+}
+
+func TestDialogHandler(t *testing.T) {
+	dialog := newTestDialog()
+	dialog.DeniedPrompts = Prompts{EnUs: "Okay, cancelled."}
+
+	completed := 0
+	fn := dialog.Handler(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		completed++
+		return NewResponseBuilder().Speak("Coming right up").Build()
+	})
+
+	res, err := fn(context.Background(), newTestDialogRequest(EnUs, None))
+	if err != nil || completed != 0 {
+		t.Fatalf("Unexpected result: %v, %d", err, completed)
+	}
+
+	if _, ok := res.Response.Directives[0].(DialogElicitSlotDirective); !ok {
+		t.Errorf("Expected a Dialog.ElicitSlot directive, got %T", res.Response.Directives[0])
+	}
+
+	res, _ = fn(context.Background(), newTestDialogRequest(EnUs, Denied))
+	if res.Response.OutputSpeech.Text != "Okay, cancelled." || res.Response.ShouldEndSession != SessionEnd {
+		t.Errorf("Unexpected denied response: %+v", res.Response)
+	}
+
+	res, err = fn(context.Background(), newTestDialogRequest(EnUs, Confirmed, Slot{Name: "drink", Value: "tea"}, Slot{Name: "size", Value: "small", ConfirmationStatus: Confirmed}))
+	if err != nil || completed != 1 {
+		t.Fatalf("Unexpected result: %v, %d", err, completed)
+	}
+
+	actual, expected := res.Response.OutputSpeech.Text, "Coming right up"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	dialog.DeniedPrompts = nil
+	fn = dialog.Handler(func(ctx context.Context, req AlexaRequest) (AlexaResponse, error) {
+		completed++
+		return AlexaResponse{}, nil
+	})
+	fn(context.Background(), newTestDialogRequest(EnUs, Denied))
+	if completed != 2 {
+		t.Errorf("Expected the denied intent to be handed over, got %d", completed)
+	}
+}
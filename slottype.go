@@ -0,0 +1,332 @@
+package alexado
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SlotValueError is returned when the value of a slot cannot be parsed as one of the AMAZON built-in slot types
+type SlotValueError struct {
+	Slot      string // Name of the slot
+	Type      string // Built-in slot type the value was parsed as, such as AMAZON.DATE
+	Value     string // Value of the slot
+	Reason    string // Why the value could not be parsed
+	Ambiguous bool   // The value is well formed but does not identify a single Go value, such as 2019-XX or ?
+}
+
+func (e *SlotValueError) Error() string {
+	return fmt.Sprintf("alexado: slot %s: cannot parse %q as %s: %s", e.Slot, e.Value, e.Type, e.Reason)
+}
+
+func (s Slot) valueError(typ, reason string, ambiguous bool) *SlotValueError {
+	if s.Value == "" {
+		reason, ambiguous = "the slot has no value", false
+	}
+
+	return &SlotValueError{Slot: s.Name, Type: typ, Value: s.Value, Reason: reason, Ambiguous: ambiguous}
+}
+
+// DateRange is the span of days an AMAZON.DATE value refers to. Dates are in UTC as Alexa does not send the time
+// zone of the user.
+type DateRange struct {
+	Start   time.Time // First day of the range
+	End     time.Time // Day following the last day of the range
+	Present bool      // The user referred to the present, such as "now". Start and End are zero.
+}
+
+// Contains reports whether t falls within r.
+func (r DateRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+var (
+	datePattern    = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+	weekPattern    = regexp.MustCompile(`^(\d{4})-W(\d{2})(-WE)?$`)
+	monthPattern   = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+	yearPattern    = regexp.MustCompile(`^(\d{4})$`)
+	decadePattern  = regexp.MustCompile(`^(\d{3})X$`)
+	seasonPattern  = regexp.MustCompile(`^(\d{4})-(SP|SU|FA|WI)$`)
+	seasonStartsIn = map[string]time.Month{"SP": time.March, "SU": time.June, "FA": time.September, "WI": time.December}
+)
+
+// Date parses the value of an AMAZON.DATE slot, such as 2019-02-23, 2019-W08, 2019-W08-WE, 2019-02, 2019, 201X,
+// 2019-WI or PRESENT_REF. Seasons are the meteorological seasons of the northern hemisphere.
+// Values leaving part of the date unspecified, such as 2019-XX, return a *SlotValueError with Ambiguous set.
+func (s Slot) Date() (DateRange, error) {
+	const typ = "AMAZON.DATE"
+	v := s.Value
+
+	if v == "PRESENT_REF" {
+		return DateRange{Present: true}, nil
+	}
+
+	if m := datePattern.FindStringSubmatch(v); m != nil {
+		year, month, day := atoi(m[1]), atoi(m[2]), atoi(m[3])
+		start := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if start.Month() != time.Month(month) || start.Day() != day {
+			return DateRange{}, s.valueError(typ, "no such day", false)
+		}
+
+		return DateRange{Start: start, End: start.AddDate(0, 0, 1)}, nil
+	}
+
+	if m := weekPattern.FindStringSubmatch(v); m != nil {
+		year, week := atoi(m[1]), atoi(m[2])
+		if week < 1 || week > isoWeeks(year) {
+			return DateRange{}, s.valueError(typ, "no such week", false)
+		}
+
+		start := isoWeekStart(year, week)
+		if m[3] != "" {
+			return DateRange{Start: start.AddDate(0, 0, 5), End: start.AddDate(0, 0, 7)}, nil
+		}
+
+		return DateRange{Start: start, End: start.AddDate(0, 0, 7)}, nil
+	}
+
+	if m := monthPattern.FindStringSubmatch(v); m != nil {
+		month := atoi(m[2])
+		if month < 1 || month > 12 {
+			return DateRange{}, s.valueError(typ, "no such month", false)
+		}
+
+		start := time.Date(atoi(m[1]), time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+
+		return DateRange{Start: start, End: start.AddDate(0, 1, 0)}, nil
+	}
+
+	if m := yearPattern.FindStringSubmatch(v); m != nil {
+		start := time.Date(atoi(m[1]), time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		return DateRange{Start: start, End: start.AddDate(1, 0, 0)}, nil
+	}
+
+	if m := decadePattern.FindStringSubmatch(v); m != nil {
+		start := time.Date(atoi(m[1])*10, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		return DateRange{Start: start, End: start.AddDate(10, 0, 0)}, nil
+	}
+
+	if m := seasonPattern.FindStringSubmatch(v); m != nil {
+		start := time.Date(atoi(m[1]), seasonStartsIn[m[2]], 1, 0, 0, 0, 0, time.UTC)
+
+		return DateRange{Start: start, End: start.AddDate(0, 3, 0)}, nil
+	}
+
+	if containsX(v) {
+		return DateRange{}, s.valueError(typ, "part of the date is not specified", true)
+	}
+
+	return DateRange{}, s.valueError(typ, "unrecognized format", false)
+}
+
+// isoWeekStart returns the Monday of the given ISO 8601 week. Week 1 is the week holding the 4th of January.
+func isoWeekStart(year, week int) time.Time {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+
+	return monday.AddDate(0, 0, (week-1)*7)
+}
+
+func isoWeeks(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+
+	return week
+}
+
+var durationPattern = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)Y)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)W)?(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// Duration parses the ISO 8601 value of an AMAZON.DURATION slot, such as PT10M or P2DT3H. Weeks are 7 days and days
+// are 24 hours. Durations in years or months have no fixed length and return a *SlotValueError.
+func (s Slot) Duration() (time.Duration, error) {
+	const typ = "AMAZON.DURATION"
+
+	m := durationPattern.FindStringSubmatch(s.Value)
+	if m == nil || s.Value == "P" || s.Value[len(s.Value)-1] == 'T' {
+		if containsX(s.Value) {
+			return 0, s.valueError(typ, "part of the duration is not specified", true)
+		}
+
+		return 0, s.valueError(typ, "unrecognized format", false)
+	}
+
+	if m[1] != "" || m[2] != "" {
+		return 0, s.valueError(typ, "years and months have no fixed duration", false)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var d time.Duration
+	for i, unit := range units {
+		if n := m[i+3]; n != "" {
+			f, _ := strconv.ParseFloat(n, 64)
+			d += time.Duration(f * float64(unit))
+		}
+	}
+
+	return d, nil
+}
+
+// TimeOfDay is the value of an AMAZON.TIME slot: either a time, or a period of the day when the user did not say a
+// specific time, such as "this evening".
+type TimeOfDay struct {
+	Hour   int            // Hour of the day, 0 to 23
+	Minute int            // Minute of the hour
+	Period TimePeriodType // Period of the day. Set instead of Hour and Minute.
+}
+
+func (t TimeOfDay) String() string {
+	if t.Period != 0 {
+		return t.Period.String()
+	}
+
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+// Duration returns the time elapsed since midnight. It returns 0 for a period of the day.
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute
+}
+
+var timePattern = regexp.MustCompile(`^(\d{2}):(\d{2})$`)
+
+// Time parses the value of an AMAZON.TIME slot, such as 14:25, or one of the periods MO, AF, EV and NI.
+func (s Slot) Time() (TimeOfDay, error) {
+	const typ = "AMAZON.TIME"
+
+	if m := timePattern.FindStringSubmatch(s.Value); m != nil {
+		hour, minute := atoi(m[1]), atoi(m[2])
+		if hour > 23 || minute > 59 {
+			return TimeOfDay{}, s.valueError(typ, "no such time", false)
+		}
+
+		return TimeOfDay{Hour: hour, Minute: minute}, nil
+	}
+
+	if period, err := ParseTimePeriodType(s.Value); err == nil {
+		return TimeOfDay{Period: period}, nil
+	}
+
+	if containsX(s.Value) {
+		return TimeOfDay{}, s.valueError(typ, "part of the time is not specified", true)
+	}
+
+	return TimeOfDay{}, s.valueError(typ, "unrecognized format", false)
+}
+
+var numberPattern = regexp.MustCompile(`^-?\d+$`)
+
+// Number parses the value of an AMAZON.NUMBER slot. Alexa sends ? when it heard a number it could not recognize,
+// which returns a *SlotValueError with Ambiguous set.
+func (s Slot) Number() (int, error) {
+	const typ = "AMAZON.NUMBER"
+
+	if s.Value == "?" {
+		return 0, s.valueError(typ, "the number was not recognized", true)
+	}
+
+	if !numberPattern.MatchString(s.Value) {
+		return 0, s.valueError(typ, "not a number", false)
+	}
+
+	n, err := strconv.Atoi(s.Value)
+	if err != nil {
+		return 0, s.valueError(typ, "out of range", false)
+	}
+
+	return n, nil
+}
+
+var fourDigitNumberPattern = regexp.MustCompile(`^\d{4}$`)
+
+// FourDigitNumber parses the value of an AMAZON.FOUR_DIGIT_NUMBER slot. Leading zeros are not kept: read Value when
+// they matter, as for a PIN.
+func (s Slot) FourDigitNumber() (int, error) {
+	const typ = "AMAZON.FOUR_DIGIT_NUMBER"
+
+	if s.Value == "?" {
+		return 0, s.valueError(typ, "the number was not recognized", true)
+	}
+
+	if !fourDigitNumberPattern.MatchString(s.Value) {
+		return 0, s.valueError(typ, "not a four digit number", false)
+	}
+
+	return atoi(s.Value), nil
+}
+
+// atoi converts strings already validated as digits.
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+
+	return n
+}
+
+// containsX reports whether s holds the X placeholder Alexa uses for the parts of a value the user did not specify.
+func containsX(s string) bool {
+	return strings.Contains(s, "X")
+}
+
+// TimePeriodType is a period of the day sent by AMAZON.TIME slots when the user did not say a specific time
+type TimePeriodType int
+
+const (
+	// Morning is sent for utterances such as "this morning"
+	Morning TimePeriodType = iota + 1
+	// Afternoon is sent for utterances such as "this afternoon"
+	Afternoon
+	// Evening is sent for utterances such as "this evening"
+	Evening
+	// Night is sent for utterances such as "tonight"
+	Night
+)
+
+var timePeriodTypeNames = [...]string{
+	"",
+	"MO",
+	"AF",
+	"EV",
+	"NI",
+}
+
+func (p TimePeriodType) String() string {
+	return enumString(timePeriodTypeNames[:], int(p))
+}
+
+// IsValid reports whether p is one of the TimePeriodType values. The unset zero value is not valid.
+func (p TimePeriodType) IsValid() bool {
+	return validEnum(timePeriodTypeNames[:], int(p))
+}
+
+// TimePeriodTypeValues returns every TimePeriodType value in declaration order.
+func TimePeriodTypeValues() []TimePeriodType {
+	values := make([]TimePeriodType, len(timePeriodTypeNames)-1)
+	for i := range values {
+		values[i] = TimePeriodType(i + 1)
+	}
+
+	return values
+}
+
+// ParseTimePeriodType returns the TimePeriodType whose string value is s.
+func ParseTimePeriodType(s string) (TimePeriodType, error) {
+	i, err := parseEnum("TimePeriodType", timePeriodTypeNames[:], s)
+
+	return TimePeriodType(i), err
+}
+
+// MarshalJSON encodes p as its string value.
+func (p TimePeriodType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("TimePeriodType", timePeriodTypeNames[:], int(p))
+}
+
+// UnmarshalJSON decodes p from its string value. Unknown values, an empty string or null leave p unset.
+func (p *TimePeriodType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(timePeriodTypeNames[:], data)
+	*p = TimePeriodType(i)
+
+	return err
+}
@@ -0,0 +1,218 @@
+package alexado
+
+import (
+	"testing"
+	"time"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSlotDate(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected DateRange
+	}{
+		{"2019-02-23", DateRange{Start: day(2019, 2, 23), End: day(2019, 2, 24)}},
+		{"2019-W08", DateRange{Start: day(2019, 2, 18), End: day(2019, 2, 25)}},
+		{"2019-W08-WE", DateRange{Start: day(2019, 2, 23), End: day(2019, 2, 25)}},
+		{"2021-W01", DateRange{Start: day(2021, 1, 4), End: day(2021, 1, 11)}},
+		{"2020-W53", DateRange{Start: day(2020, 12, 28), End: day(2021, 1, 4)}},
+		{"2019-02", DateRange{Start: day(2019, 2, 1), End: day(2019, 3, 1)}},
+		{"2019", DateRange{Start: day(2019, 1, 1), End: day(2020, 1, 1)}},
+		{"201X", DateRange{Start: day(2010, 1, 1), End: day(2020, 1, 1)}},
+		{"2019-SU", DateRange{Start: day(2019, 6, 1), End: day(2019, 9, 1)}},
+		{"2019-WI", DateRange{Start: day(2019, 12, 1), End: day(2020, 3, 1)}},
+		{"PRESENT_REF", DateRange{Present: true}},
+	}
+
+	for _, test := range tests {
+		actual, err := Slot{Name: "date", Value: test.value}.Date()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.value, err)
+			continue
+		}
+
+		if !actual.Start.Equal(test.expected.Start) || !actual.End.Equal(test.expected.End) || actual.Present != test.expected.Present {
+			t.Errorf("%s: '%v' != '%v'", test.value, actual, test.expected)
+		}
+	}
+}
+
+func TestSlotDateErrors(t *testing.T) {
+	tests := []struct {
+		value     string
+		ambiguous bool
+	}{
+		{"2019-XX", true},
+		{"XXXX-02-23", true},
+		{"2019-02-30", false},
+		{"2019-13", false},
+		{"2019-W54", false},
+		{"2019-W53", false},
+		{"friday", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		_, err := Slot{Name: "date", Value: test.value}.Date()
+		valueErr, ok := err.(*SlotValueError)
+		if !ok {
+			t.Errorf("%s: expected *SlotValueError, got %v", test.value, err)
+			continue
+		}
+
+		if valueErr.Ambiguous != test.ambiguous {
+			t.Errorf("%s: '%t' != '%t'", test.value, valueErr.Ambiguous, test.ambiguous)
+		}
+	}
+
+	_, err := Slot{Name: "date", Value: "2019-XX"}.Date()
+	actual, expected := err.Error(), `alexado: slot date: cannot parse "2019-XX" as AMAZON.DATE: part of the date is not specified`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestDateRangeContains(t *testing.T) {
+	r, _ := Slot{Value: "2019-02"}.Date()
+
+	if !r.Contains(day(2019, 2, 1)) || !r.Contains(day(2019, 2, 28).Add(23*time.Hour)) {
+		t.Error("Expected February to contain its days")
+	}
+
+	if r.Contains(day(2019, 3, 1)) || r.Contains(day(2019, 1, 31)) {
+		t.Error("Expected February not to contain other months")
+	}
+}
+
+func TestSlotDuration(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{"PT10M", 10 * time.Minute},
+		{"PT1H30M", 90 * time.Minute},
+		{"PT45S", 45 * time.Second},
+		{"PT0.5H", 30 * time.Minute},
+		{"P2D", 48 * time.Hour},
+		{"P1W", 7 * 24 * time.Hour},
+		{"P1DT2H", 26 * time.Hour},
+	}
+
+	for _, test := range tests {
+		actual, err := Slot{Value: test.value}.Duration()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.value, err)
+			continue
+		}
+
+		if actual != test.expected {
+			t.Errorf("%s: '%s' != '%s'", test.value, actual, test.expected)
+		}
+	}
+
+	for _, value := range []string{"P1Y", "P2M", "P1YT1H", "PT", "P", "10 minutes", "PTXM", ""} {
+		if _, err := (Slot{Value: value}).Duration(); err == nil {
+			t.Errorf("%s: expected an error", value)
+		}
+	}
+
+	_, err := Slot{Value: "PTXM"}.Duration()
+	if valueErr, ok := err.(*SlotValueError); !ok || !valueErr.Ambiguous {
+		t.Errorf("Expected an ambiguous *SlotValueError, got %v", err)
+	}
+}
+
+func TestSlotTime(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected TimeOfDay
+	}{
+		{"14:25", TimeOfDay{Hour: 14, Minute: 25}},
+		{"00:00", TimeOfDay{}},
+		{"MO", TimeOfDay{Period: Morning}},
+		{"AF", TimeOfDay{Period: Afternoon}},
+		{"EV", TimeOfDay{Period: Evening}},
+		{"NI", TimeOfDay{Period: Night}},
+	}
+
+	for _, test := range tests {
+		actual, err := Slot{Value: test.value}.Time()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.value, err)
+			continue
+		}
+
+		if actual != test.expected {
+			t.Errorf("%s: '%v' != '%v'", test.value, actual, test.expected)
+		}
+
+		if actual.String() != test.value {
+			t.Errorf("'%s' != '%s'", actual.String(), test.value)
+		}
+	}
+
+	for _, value := range []string{"24:00", "12:60", "noon", "XX:30", ""} {
+		if _, err := (Slot{Value: value}).Time(); err == nil {
+			t.Errorf("%s: expected an error", value)
+		}
+	}
+
+	tod, _ := Slot{Value: "01:30"}.Time()
+	actual, expected := tod.Duration(), 90*time.Minute
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestSlotNumber(t *testing.T) {
+	actual, err := Slot{Value: "42"}.Number()
+	if err != nil || actual != 42 {
+		t.Errorf("'%d' != '%d': %v", actual, 42, err)
+	}
+
+	actual, err = Slot{Value: "-7"}.Number()
+	if err != nil || actual != -7 {
+		t.Errorf("'%d' != '%d': %v", actual, -7, err)
+	}
+
+	_, err = Slot{Value: "?"}.Number()
+	if valueErr, ok := err.(*SlotValueError); !ok || !valueErr.Ambiguous {
+		t.Errorf("Expected an ambiguous *SlotValueError, got %v", err)
+	}
+
+	for _, value := range []string{"forty two", "4.2", "99999999999999999999", ""} {
+		if _, err := (Slot{Value: value}).Number(); err == nil {
+			t.Errorf("%s: expected an error", value)
+		}
+	}
+}
+
+func TestSlotFourDigitNumber(t *testing.T) {
+	actual, err := Slot{Value: "0042"}.FourDigitNumber()
+	if err != nil || actual != 42 {
+		t.Errorf("'%d' != '%d': %v", actual, 42, err)
+	}
+
+	for _, value := range []string{"123", "12345", "-123", "?", ""} {
+		if _, err := (Slot{Value: value}).FourDigitNumber(); err == nil {
+			t.Errorf("%s: expected an error", value)
+		}
+	}
+}
+
+func TestTimePeriodTypeString(t *testing.T) {
+	var actual, expected string
+
+	actual, expected = Morning.String(), "MO"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = Night.String(), "NI"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
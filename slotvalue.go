@@ -0,0 +1,116 @@
+package alexado
+
+// SlotValue is the value of a slot as sent in the slotValue property. Slots accepting multiple values have a List
+// value holding one Simple value per value the user said.
+type SlotValue struct {
+	Type        SlotValueType `json:"type"`                  // Simple or List
+	Value       string        `json:"value,omitempty"`       // Simple: value the user said
+	Resolutions *Resolutions  `json:"resolutions,omitempty"` // Simple: results of entity resolution
+	Values      []SlotValue   `json:"values,omitempty"`      // List: values the user said
+}
+
+// IsMatch reports whether v resolved to a canonical value.
+func (v SlotValue) IsMatch() bool {
+	return v.Resolutions.IsMatch()
+}
+
+// ResolvedID returns the ID of the canonical value v resolved to, or an empty string when it did not resolve.
+func (v SlotValue) ResolvedID() string {
+	return v.Resolutions.ResolvedValue().ID
+}
+
+// ResolvedValue returns the canonical name of the value v resolved to, or the spoken value when it did not resolve.
+func (v SlotValue) ResolvedValue() string {
+	if r := v.Resolutions.ResolvedValue(); r.Name != "" {
+		return r.Name
+	}
+
+	return v.Value
+}
+
+// Values returns the values of s as Simple values, whether the slot holds a single value or a list. Requests without
+// a slotValue property fall back to Value and Resolutions. It returns nil when the slot is empty.
+func (s Slot) Values() []SlotValue {
+	if s.SlotValue != nil {
+		return s.SlotValue.simple()
+	}
+
+	if s.Value == "" {
+		return nil
+	}
+
+	return []SlotValue{{Type: SimpleSlotValue, Value: s.Value, Resolutions: s.Resolutions}}
+}
+
+func (v SlotValue) simple() []SlotValue {
+	if v.Type != ListSlotValue {
+		return []SlotValue{v}
+	}
+
+	var values []SlotValue
+	for _, value := range v.Values {
+		values = append(values, value.simple()...)
+	}
+
+	return values
+}
+
+// IsList reports whether s holds a list of values.
+func (s Slot) IsList() bool {
+	return s.SlotValue != nil && s.SlotValue.Type == ListSlotValue
+}
+
+// SlotValueType describes whether a slotValue holds a single value or a list of values
+type SlotValueType int
+
+const (
+	// SimpleSlotValue holds a single value
+	SimpleSlotValue SlotValueType = iota + 1
+	// ListSlotValue holds a list of Simple values
+	ListSlotValue
+)
+
+var slotValueTypeNames = [...]string{
+	"",
+	"Simple",
+	"List",
+}
+
+func (t SlotValueType) String() string {
+	return enumString(slotValueTypeNames[:], int(t))
+}
+
+// IsValid reports whether t is one of the SlotValueType values. The unset zero value is not valid.
+func (t SlotValueType) IsValid() bool {
+	return validEnum(slotValueTypeNames[:], int(t))
+}
+
+// SlotValueTypeValues returns every SlotValueType value in declaration order.
+func SlotValueTypeValues() []SlotValueType {
+	values := make([]SlotValueType, len(slotValueTypeNames)-1)
+	for i := range values {
+		values[i] = SlotValueType(i + 1)
+	}
+
+	return values
+}
+
+// ParseSlotValueType returns the SlotValueType whose string value is s.
+func ParseSlotValueType(s string) (SlotValueType, error) {
+	i, err := parseEnum("SlotValueType", slotValueTypeNames[:], s)
+
+	return SlotValueType(i), err
+}
+
+// MarshalJSON encodes t as its string value.
+func (t SlotValueType) MarshalJSON() ([]byte, error) {
+	return marshalEnum("SlotValueType", slotValueTypeNames[:], int(t))
+}
+
+// UnmarshalJSON decodes t from its string value. Unknown values, an empty string or null leave t unset.
+func (t *SlotValueType) UnmarshalJSON(data []byte) error {
+	i, err := unmarshalEnum(slotValueTypeNames[:], data)
+	*t = SlotValueType(i)
+
+	return err
+}
@@ -0,0 +1,122 @@
+package alexado
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSlotValueTypeString(t *testing.T) {
+	var actual, expected string
+
+	actual, expected = SimpleSlotValue.String(), "Simple"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = ListSlotValue.String(), "List"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestSlotValueUnmarshalsCorrectly(t *testing.T) {
+	toppings := readEntityResolutionSlots(t)["toppings"]
+	if toppings.SlotValue == nil {
+		t.Fatal("Expected a slotValue on the toppings slot")
+	}
+
+	var actual, expected interface{}
+
+	actual, expected = toppings.SlotValue.Type, ListSlotValue
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = len(toppings.SlotValue.Values), 3
+	if actual != expected {
+		t.Errorf("'%d' != '%d'", actual, expected)
+	}
+
+	actual, expected = toppings.Value, ""
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if !toppings.IsList() {
+		t.Error("Expected the toppings slot to be a list")
+	}
+
+	if readEntityResolutionSlots(t)["drink"].IsList() {
+		t.Error("Expected the drink slot not to be a list")
+	}
+}
+
+func TestSlotValues(t *testing.T) {
+	slots := readEntityResolutionSlots(t)
+
+	tests := []struct {
+		slot     string
+		values   []string
+		resolved []string
+	}{
+		{"toppings", []string{"cinnamon", "choc", "glitter"}, []string{"cinnamon", "chocolate", "glitter"}},
+		{"drink", []string{"flat white"}, []string{"flat white"}},
+		{"milk", []string{"oat"}, []string{"oat milk"}},
+		{"note", nil, nil},
+		{"missing", nil, nil},
+	}
+
+	for _, test := range tests {
+		values := slots[test.slot].Values()
+		if len(values) != len(test.values) {
+			t.Errorf("%s: '%d' != '%d'", test.slot, len(values), len(test.values))
+			continue
+		}
+
+		for i, v := range values {
+			if v.Type != SimpleSlotValue {
+				t.Errorf("%s: '%s' != '%s'", test.slot, v.Type, SimpleSlotValue)
+			}
+
+			if v.Value != test.values[i] {
+				t.Errorf("%s: '%s' != '%s'", test.slot, v.Value, test.values[i])
+			}
+
+			if v.ResolvedValue() != test.resolved[i] {
+				t.Errorf("%s: '%s' != '%s'", test.slot, v.ResolvedValue(), test.resolved[i])
+			}
+		}
+	}
+
+	values := slots["toppings"].Values()
+
+	actual, expected := values[1].ResolvedID(), "CHOCOLATE"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	if values[2].IsMatch() {
+		t.Error("Expected glitter not to match")
+	}
+}
+
+func TestSlotValueToJSON(t *testing.T) {
+	slot := Slot{Name: "toppings", SlotValue: &SlotValue{Type: ListSlotValue, Values: []SlotValue{
+		{Type: SimpleSlotValue, Value: "cinnamon"},
+	}}}
+
+	b, _ := json.Marshal(slot.SlotValue)
+	actual, expected := string(b), `{"type":"List","values":[{"type":"Simple","value":"cinnamon"}]}`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	var decoded SlotValue
+	if err := json.Unmarshal([]byte(`{"type":"Tree","value":"cinnamon"}`), &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if decoded.Type != SlotValueType(0) {
+		t.Errorf("'%s' != ''", decoded.Type)
+	}
+}
@@ -0,0 +1,335 @@
+package alexado
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSpeechLength is the maximum number of characters of output speech, markup included
+	MaxSpeechLength = 8000
+	// MaxAudioClips is the maximum number of audio tags in a single output speech
+	MaxAudioClips = 5
+	// MaxBreakTime is the longest pause a break tag can request
+	MaxBreakTime = 10 * time.Second
+)
+
+// SSMLBuilder builds SSML for OutputSpeech. Text passed to its methods is escaped.
+type SSMLBuilder struct {
+	content strings.Builder
+}
+
+// NewSSML returns an empty SSMLBuilder.
+func NewSSML() *SSMLBuilder {
+	return &SSMLBuilder{}
+}
+
+// Text appends plain text.
+func (s *SSMLBuilder) Text(text string) *SSMLBuilder {
+	s.content.WriteString(escapeSSML(text))
+
+	return s
+}
+
+// Raw appends markup as is, which is useful for nesting the Fragment of another SSMLBuilder.
+func (s *SSMLBuilder) Raw(markup string) *SSMLBuilder {
+	s.content.WriteString(markup)
+
+	return s
+}
+
+// Break appends a pause of duration d.
+func (s *SSMLBuilder) Break(d time.Duration) *SSMLBuilder {
+	return s.empty("break", "time", strconv.FormatInt(int64(d/time.Millisecond), 10)+"ms")
+}
+
+// BreakStrength appends a pause of the given strength: none, x-weak, weak, medium, strong or x-strong.
+func (s *SSMLBuilder) BreakStrength(strength string) *SSMLBuilder {
+	return s.empty("break", "strength", strength)
+}
+
+// Emphasis appends text spoken with the given level of emphasis: strong, moderate or reduced.
+func (s *SSMLBuilder) Emphasis(level, text string) *SSMLBuilder {
+	return s.element("emphasis", text, "level", level)
+}
+
+// Prosody appends text spoken with the given rate, pitch and volume. Empty values are left out.
+func (s *SSMLBuilder) Prosody(rate, pitch, volume, text string) *SSMLBuilder {
+	return s.element("prosody", text, "rate", rate, "pitch", pitch, "volume", volume)
+}
+
+// SayAs appends text interpreted as the given type, such as characters, cardinal, date or telephone.
+// format is only used with the date type and is left out when empty.
+func (s *SSMLBuilder) SayAs(interpretAs, format, text string) *SSMLBuilder {
+	return s.element("say-as", text, "interpret-as", interpretAs, "format", format)
+}
+
+// Phoneme appends text pronounced with the phonetic pronunciation ph written in alphabet: ipa or x-sampa.
+func (s *SSMLBuilder) Phoneme(alphabet, ph, text string) *SSMLBuilder {
+	return s.element("phoneme", text, "alphabet", alphabet, "ph", ph)
+}
+
+// Audio appends the MP3 clip found at the https URL src.
+func (s *SSMLBuilder) Audio(src string) *SSMLBuilder {
+	return s.empty("audio", "src", src)
+}
+
+// Paragraph appends text as a paragraph.
+func (s *SSMLBuilder) Paragraph(text string) *SSMLBuilder {
+	return s.element("p", text)
+}
+
+// Sentence appends text as a sentence.
+func (s *SSMLBuilder) Sentence(text string) *SSMLBuilder {
+	return s.element("s", text)
+}
+
+// Sub appends text pronounced as alias.
+func (s *SSMLBuilder) Sub(alias, text string) *SSMLBuilder {
+	return s.element("sub", text, "alias", alias)
+}
+
+// Voice appends text spoken by the Amazon Polly voice name.
+func (s *SSMLBuilder) Voice(name, text string) *SSMLBuilder {
+	return s.element("voice", text, "name", name)
+}
+
+// Lang appends text spoken in the language lang, such as fr-FR.
+func (s *SSMLBuilder) Lang(lang, text string) *SSMLBuilder {
+	return s.element("lang", text, "xml:lang", lang)
+}
+
+// Effect appends text spoken with the effect name, such as whispered.
+func (s *SSMLBuilder) Effect(name, text string) *SSMLBuilder {
+	return s.element("amazon:effect", text, "name", name)
+}
+
+// Domain appends text spoken in the style of the domain name, such as news or conversational.
+func (s *SSMLBuilder) Domain(name, text string) *SSMLBuilder {
+	return s.element("amazon:domain", text, "name", name)
+}
+
+// Fragment returns the markup built so far without the enclosing speak tag.
+func (s *SSMLBuilder) Fragment() string {
+	return s.content.String()
+}
+
+// String returns the markup built so far enclosed in a speak tag.
+func (s *SSMLBuilder) String() string {
+	return "<speak>" + s.content.String() + "</speak>"
+}
+
+// Build returns the markup enclosed in a speak tag, or an *SSMLError when it fails ValidateSSML.
+func (s *SSMLBuilder) Build() (string, error) {
+	ssml := s.String()
+	if err := ValidateSSML(ssml); err != nil {
+		return "", err
+	}
+
+	return ssml, nil
+}
+
+func (s *SSMLBuilder) element(name, text string, attrs ...string) *SSMLBuilder {
+	s.open(name, attrs)
+	s.content.WriteString(">")
+	s.content.WriteString(escapeSSML(text))
+	s.content.WriteString("</" + name + ">")
+
+	return s
+}
+
+func (s *SSMLBuilder) empty(name string, attrs ...string) *SSMLBuilder {
+	s.open(name, attrs)
+	s.content.WriteString("/>")
+
+	return s
+}
+
+func (s *SSMLBuilder) open(name string, attrs []string) {
+	s.content.WriteString("<" + name)
+
+	for i := 0; i+1 < len(attrs); i += 2 {
+		if attrs[i+1] == "" {
+			continue
+		}
+
+		s.content.WriteString(" " + attrs[i] + `="` + escapeSSML(attrs[i+1]) + `"`)
+	}
+}
+
+func escapeSSML(text string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(text))
+
+	return b.String()
+}
+
+// SSMLError lists the problems found by ValidateSSML
+type SSMLError struct {
+	Problems []string
+}
+
+func (e *SSMLError) Error() string {
+	return "alexado: invalid SSML: " + strings.Join(e.Problems, "; ")
+}
+
+// ssmlAttributeValues lists the allowed values of enumerated attributes per tag
+var ssmlAttributeValues = map[string]map[string][]string{
+	"break":         {"strength": {"none", "x-weak", "weak", "medium", "strong", "x-strong"}},
+	"emphasis":      {"level": {"strong", "moderate", "reduced"}},
+	"phoneme":       {"alphabet": {"ipa", "x-sampa"}},
+	"amazon:effect": {"name": {"whispered"}},
+	"amazon:domain": {"name": {"conversational", "long-form", "music", "news", "fun"}},
+	"say-as": {"interpret-as": {
+		"characters", "spell-out", "cardinal", "number", "ordinal", "digits", "fraction", "unit",
+		"date", "time", "telephone", "address", "interjection", "expletive",
+	}},
+}
+
+// ssmlTags lists the supported tags
+var ssmlTags = map[string]bool{
+	"speak": true, "break": true, "emphasis": true, "lang": true, "p": true, "phoneme": true, "prosody": true,
+	"s": true, "say-as": true, "sub": true, "voice": true, "w": true, "audio": true, "mark": true,
+	"amazon:effect": true, "amazon:domain": true, "amazon:emotion": true,
+}
+
+var breakTimePattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)(ms|s)$`)
+
+// ValidateSSML reports unsupported tags, invalid attribute values, malformed markup and exceeded limits in ssml.
+func ValidateSSML(ssml string) error {
+	var problems []string
+
+	if n := utf8.RuneCountInString(ssml); n > MaxSpeechLength {
+		problems = append(problems, fmt.Sprintf("speech is %d characters long, the limit is %d", n, MaxSpeechLength))
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(ssml))
+	depth, roots, audio := 0, 0, 0
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			problems = append(problems, "malformed markup: "+err.Error())
+			break
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := qualifiedName(t.Name)
+
+			switch {
+			case depth == 0 && name != "speak":
+				problems = append(problems, "speech must be enclosed in a speak tag")
+			case depth > 0 && name == "speak":
+				problems = append(problems, "speak tags cannot be nested")
+			}
+
+			if depth == 0 {
+				roots++
+				if roots == 2 {
+					problems = append(problems, "speech must be enclosed in a single speak tag")
+				}
+			}
+
+			if !ssmlTags[name] {
+				problems = append(problems, fmt.Sprintf("unsupported tag <%s>", name))
+			}
+
+			if name == "audio" {
+				audio++
+			}
+
+			problems = append(problems, validateSSMLAttributes(name, t.Attr)...)
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(t)) != "" {
+				problems = append(problems, "speech must be enclosed in a speak tag")
+			}
+		}
+	}
+
+	if audio > MaxAudioClips {
+		problems = append(problems, fmt.Sprintf("speech has %d audio clips, the limit is %d", audio, MaxAudioClips))
+	}
+
+	if len(problems) > 0 {
+		return &SSMLError{Problems: problems}
+	}
+
+	return nil
+}
+
+func validateSSMLAttributes(name string, attrs []xml.Attr) []string {
+	var problems []string
+
+	for _, attr := range attrs {
+		key, value := qualifiedName(attr.Name), attr.Value
+
+		if allowed, ok := ssmlAttributeValues[name][key]; ok && !contains(allowed, value) {
+			problems = append(problems, fmt.Sprintf("<%s> does not support %s=%q", name, key, value))
+		}
+
+		switch {
+		case name == "break" && key == "time":
+			if d, ok := parseBreakTime(value); !ok {
+				problems = append(problems, fmt.Sprintf("<break> time %q is malformed", value))
+			} else if d > MaxBreakTime {
+				problems = append(problems, fmt.Sprintf("<break> time %q exceeds %s", value, MaxBreakTime))
+			}
+		case name == "audio" && key == "src":
+			if !strings.HasPrefix(value, "https://") {
+				problems = append(problems, fmt.Sprintf("<audio> src %q must use https", value))
+			}
+		}
+	}
+
+	return problems
+}
+
+func parseBreakTime(value string) (time.Duration, bool) {
+	match := breakTimePattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, false
+	}
+
+	f, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, false
+	}
+
+	if match[2] == "s" {
+		return time.Duration(f * float64(time.Second)), true
+	}
+
+	return time.Duration(f * float64(time.Millisecond)), true
+}
+
+func qualifiedName(n xml.Name) string {
+	if n.Space == "" {
+		return n.Local
+	}
+
+	return n.Space + ":" + n.Local
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
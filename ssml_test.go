@@ -0,0 +1,112 @@
+package alexado
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSSMLBuilder(t *testing.T) {
+	actual, err := NewSSML().
+		Text("Tom & Jerry ").
+		Break(1500*time.Millisecond).
+		BreakStrength("x-strong").
+		Emphasis("strong", "really").
+		Prosody("slow", "", "loud", "slowly").
+		SayAs("date", "md", "9/23").
+		Phoneme("ipa", "ˈpi.kɑn", "pecan").
+		Audio("https://example.com/clip.mp3").
+		Paragraph("A paragraph.").
+		Sentence("A sentence.").
+		Sub("aluminum", "Al").
+		Voice("Kendra", "Hi").
+		Lang("fr-FR", "Bonjour").
+		Effect("whispered", "secret").
+		Domain("news", "Headlines").
+		Build()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<speak>Tom &amp; Jerry <break time="1500ms"/><break strength="x-strong"/><emphasis level="strong">really</emphasis>` +
+		`<prosody rate="slow" volume="loud">slowly</prosody><say-as interpret-as="date" format="md">9/23</say-as>` +
+		`<phoneme alphabet="ipa" ph="ˈpi.kɑn">pecan</phoneme><audio src="https://example.com/clip.mp3"/>` +
+		`<p>A paragraph.</p><s>A sentence.</s><sub alias="aluminum">Al</sub><voice name="Kendra">Hi</voice>` +
+		`<lang xml:lang="fr-FR">Bonjour</lang><amazon:effect name="whispered">secret</amazon:effect>` +
+		`<amazon:domain name="news">Headlines</amazon:domain></speak>`
+
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestSSMLBuilderNesting(t *testing.T) {
+	inner := NewSSML().Emphasis("moderate", "<Hi>")
+	actual := NewSSML().Raw(inner.Fragment()).String()
+
+	expected := `<speak><emphasis level="moderate">&lt;Hi&gt;</emphasis></speak>`
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestValidateSSMLAcceptsValidMarkup(t *testing.T) {
+	valid := []string{
+		`<speak>Hello</speak>`,
+		`<speak><p>One <break time="10s"/> two</p><amazon:emotion name="excited" intensity="high">Yes</amazon:emotion></speak>`,
+		`<speak><w role="amazon:VB">read</w><mark name="here"/></speak>`,
+	}
+
+	for _, ssml := range valid {
+		if err := ValidateSSML(ssml); err != nil {
+			t.Errorf("Expected '%s' to be valid, got %v", ssml, err)
+		}
+	}
+}
+
+func TestValidateSSMLReportsProblems(t *testing.T) {
+	invalid := map[string]string{
+		`Hello`:                                                           "speak",
+		`<speak>Tom & Jerry</speak>`:                                      "malformed",
+		`<speak><blink>Hi</blink></speak>`:                                "unsupported tag <blink>",
+		`<speak><break time="11s"/></speak>`:                              "exceeds",
+		`<speak><break time="soon"/></speak>`:                             "malformed",
+		`<speak><emphasis level="loud">Hi</emphasis></speak>`:             "level",
+		`<speak><amazon:effect name="shouted">Hi</amazon:effect></speak>`: "name",
+		`<speak><audio src="http://example.com/clip.mp3"/></speak>`:       "https",
+		`<speak>` + strings.Repeat(`<audio src="https://example.com/a.mp3"/>`, 6) + `</speak>`: "audio clips",
+		`<speak>` + strings.Repeat("a", MaxSpeechLength) + `</speak>`:                          "characters long",
+		`<speak>a</speak><speak>b</speak>`:                                                     "single speak tag",
+		`<speak>a <speak>b</speak></speak>`:                                                    "cannot be nested",
+	}
+
+	for ssml, problem := range invalid {
+		err := ValidateSSML(ssml)
+		if err == nil {
+			t.Errorf("Expected '%.40s' to be invalid", ssml)
+			continue
+		}
+
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("Expected '%s' to mention '%s'", err, problem)
+		}
+	}
+}
+
+func TestResponseBuilderValidatesSSML(t *testing.T) {
+	_, err := NewResponseBuilder().SpeakSSML("Tom & Jerry").Build()
+	if _, ok := err.(*BuilderError); !ok {
+		t.Errorf("Expected *BuilderError, got %v", err)
+	}
+
+	_, err = NewResponseBuilder().SpeakSSML("<speak>a</speak><speak>b</speak>").Build()
+	if _, ok := err.(*BuilderError); !ok {
+		t.Errorf("Expected *BuilderError, got %v", err)
+	}
+
+	_, err = NewResponseBuilder().RepromptSSML(NewSSML().Text("Tom & Jerry").String()).Build()
+	if err != nil {
+		t.Errorf("Expected escaped SSML to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,389 @@
+package alexado
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SignatureCertChainURLHeader is the HTTP header carrying the URL of the certificate chain used to sign the request
+	SignatureCertChainURLHeader = "SignatureCertChainUrl"
+	// SignatureHeader is the HTTP header carrying the base64 encoded SHA-256 signature of the request body
+	SignatureHeader = "Signature-256"
+	// DefaultTimestampTolerance is the maximum allowed difference between the request timestamp and the current time
+	DefaultTimestampTolerance = 150 * time.Second
+	// DefaultMaxCachedCertChains is the maximum number of certificate chains a Verifier keeps
+	DefaultMaxCachedCertChains = 16
+	// DefaultCertFetchTimeout is the maximum time HTTPCertFetcher spends downloading a certificate chain
+	DefaultCertFetchTimeout = 3 * time.Second
+	// MaxCertChainSize is the largest certificate chain, in bytes, HTTPCertFetcher downloads
+	MaxCertChainSize = 64 << 10
+
+	certChainHost       = "s3.amazonaws.com"
+	certChainPathPrefix = "/echo.api/"
+	certSubjectAltName  = "echo-api.amazon.com"
+)
+
+// CertFetcher retrieves the PEM encoded certificate chain found at the SignatureCertChainUrl
+type CertFetcher interface {
+	FetchCertChain(url string) ([]byte, error)
+}
+
+// CertFetcherFunc is an adapter allowing ordinary functions to be used as a CertFetcher
+type CertFetcherFunc func(url string) ([]byte, error)
+
+// FetchCertChain calls f(url).
+func (f CertFetcherFunc) FetchCertChain(url string) ([]byte, error) {
+	return f(url)
+}
+
+// HTTPCertFetcher downloads certificate chains over HTTP. http.DefaultClient is used when Client is nil.
+// Each download is bounded by Timeout, so that a stalled download cannot hold a request past the Alexa response
+// deadline, and chains larger than MaxCertChainSize are rejected.
+type HTTPCertFetcher struct {
+	Client  *http.Client
+	Timeout time.Duration // Maximum time of a download. DefaultCertFetchTimeout is used when zero.
+}
+
+// FetchCertChain downloads the certificate chain found at url.
+func (h HTTPCertFetcher) FetchCertChain(url string) ([]byte, error) {
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	timeout := h.Timeout
+	if timeout == 0 {
+		timeout = DefaultCertFetchTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d fetching certificate chain", res.StatusCode)
+	}
+
+	content, err := ioutil.ReadAll(io.LimitReader(res.Body, MaxCertChainSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > MaxCertChainSize {
+		return nil, fmt.Errorf("certificate chain exceeds %d bytes", MaxCertChainSize)
+	}
+
+	return content, nil
+}
+
+// VerificationError is returned when a request cannot be proven to have been sent by the Alexa platform
+type VerificationError struct {
+	Reason string // Describes which check failed
+	Err    error  // Underlying error, if any
+}
+
+func (e *VerificationError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("alexado: request verification failed: %s: %v", e.Reason, e.Err)
+	}
+
+	return fmt.Sprintf("alexado: request verification failed: %s", e.Reason)
+}
+
+// Verifier checks that requests were sent by the Alexa platform by validating the request signature,
+// the signing certificate chain and the request timestamp.
+type Verifier struct {
+	Roots     *x509.CertPool   // Trusted root certificates. The system pool is used when nil.
+	Fetcher   CertFetcher      // Retrieves certificate chains. An HTTPCertFetcher is used when nil.
+	Now       func() time.Time // Returns the current time. time.Now is used when nil.
+	Tolerance time.Duration    // Maximum age of a request. DefaultTimestampTolerance is used when zero.
+	MaxChains int              // Maximum number of cached certificate chains. DefaultMaxCachedCertChains is used when zero.
+
+	mu     sync.Mutex
+	chains map[string]cachedCertChain
+}
+
+// cachedCertChain is a verified certificate chain, kept until the first of its certificates expires.
+type cachedCertChain struct {
+	chain   []*x509.Certificate
+	expires time.Time
+}
+
+// NewVerifier returns a Verifier trusting the system root certificates and downloading certificate chains over HTTP.
+func NewVerifier() *Verifier {
+	return &Verifier{}
+}
+
+// Verify checks the signature headers and the timestamp of the raw request body.
+func (v *Verifier) Verify(header http.Header, body []byte) error {
+	if err := v.VerifySignature(header, body); err != nil {
+		return err
+	}
+
+	return v.VerifyTimestamp(body)
+}
+
+// VerifySignature checks that body was signed by the certificate referenced in the SignatureCertChainUrl header.
+func (v *Verifier) VerifySignature(header http.Header, body []byte) error {
+	chainURL := header.Get(SignatureCertChainURLHeader)
+	if chainURL == "" {
+		return &VerificationError{Reason: "missing " + SignatureCertChainURLHeader + " header"}
+	}
+
+	encoded := header.Get(SignatureHeader)
+	if encoded == "" {
+		return &VerificationError{Reason: "missing " + SignatureHeader + " header"}
+	}
+
+	if err := ValidateCertChainURL(chainURL); err != nil {
+		return err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return &VerificationError{Reason: "malformed signature", Err: err}
+	}
+
+	chain, err := v.certChain(chainURL)
+	if err != nil {
+		return err
+	}
+
+	leaf := chain[0]
+	key, ok := leaf.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return &VerificationError{Reason: "signing certificate does not hold an RSA public key"}
+	}
+
+	digest := sha256.Sum256(body)
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return &VerificationError{Reason: "signature does not match request body", Err: err}
+	}
+
+	return nil
+}
+
+// VerifyTimestamp checks that the request timestamp in body is within the allowed tolerance of the current time.
+func (v *Verifier) VerifyTimestamp(body []byte) error {
+	var payload struct {
+		Request struct {
+			Timestamp time.Time `json:"timestamp"`
+		} `json:"request"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return &VerificationError{Reason: "unable to read request timestamp", Err: err}
+	}
+
+	timestamp := payload.Request.Timestamp
+	if timestamp.IsZero() {
+		return &VerificationError{Reason: "missing request timestamp"}
+	}
+
+	tolerance := v.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultTimestampTolerance
+	}
+
+	delta := v.now().Sub(timestamp)
+	if delta < 0 {
+		delta = -delta
+	}
+
+	if delta > tolerance {
+		return &VerificationError{Reason: fmt.Sprintf("request timestamp %s is outside the %s tolerance", timestamp.Format(time.RFC3339), tolerance)}
+	}
+
+	return nil
+}
+
+// ValidateCertChainURL checks that the SignatureCertChainUrl references Amazon's certificate location.
+func ValidateCertChainURL(chainURL string) error {
+	u, err := url.Parse(chainURL)
+	if err != nil {
+		return &VerificationError{Reason: "malformed certificate chain URL", Err: err}
+	}
+
+	if !strings.EqualFold(u.Scheme, "https") {
+		return &VerificationError{Reason: "certificate chain URL scheme must be https"}
+	}
+
+	if !strings.EqualFold(u.Hostname(), certChainHost) {
+		return &VerificationError{Reason: "certificate chain URL host must be " + certChainHost}
+	}
+
+	if port := u.Port(); port != "" && port != "443" {
+		return &VerificationError{Reason: "certificate chain URL port must be 443"}
+	}
+
+	if !strings.HasPrefix(path.Clean(u.Path), certChainPathPrefix) {
+		return &VerificationError{Reason: "certificate chain URL path must begin with " + certChainPathPrefix}
+	}
+
+	return nil
+}
+
+func (v *Verifier) now() time.Time {
+	if v.Now != nil {
+		return v.Now()
+	}
+
+	return time.Now()
+}
+
+// certChain returns the verified certificate chain found at chainURL. Verified chains are cached until one of their
+// certificates expires, and the cache holds at most MaxChains chains.
+func (v *Verifier) certChain(chainURL string) ([]*x509.Certificate, error) {
+	now := v.now()
+
+	v.mu.Lock()
+	cached, ok := v.chains[chainURL]
+	if ok && !now.Before(cached.expires) {
+		delete(v.chains, chainURL)
+		ok = false
+	}
+	v.mu.Unlock()
+
+	if ok {
+		return cached.chain, nil
+	}
+
+	fetcher := v.Fetcher
+	if fetcher == nil {
+		fetcher = HTTPCertFetcher{}
+	}
+
+	content, err := fetcher.FetchCertChain(chainURL)
+	if err != nil {
+		return nil, &VerificationError{Reason: "unable to fetch certificate chain", Err: err}
+	}
+
+	chain, err := parseCertChain(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.verifyChain(chain); err != nil {
+		return nil, err
+	}
+
+	expires := chain[0].NotAfter
+	for _, cert := range chain[1:] {
+		if cert.NotAfter.Before(expires) {
+			expires = cert.NotAfter
+		}
+	}
+
+	v.mu.Lock()
+	v.cacheChain(now, chainURL, cachedCertChain{chain: chain, expires: expires})
+	v.mu.Unlock()
+
+	return chain, nil
+}
+
+// cacheChain adds c to the cache, first evicting expired chains and then, while the cache is full, the chain
+// expiring soonest. v.mu must be held.
+func (v *Verifier) cacheChain(now time.Time, chainURL string, c cachedCertChain) {
+	if v.chains == nil {
+		v.chains = make(map[string]cachedCertChain)
+	}
+
+	limit := v.MaxChains
+	if limit <= 0 {
+		limit = DefaultMaxCachedCertChains
+	}
+
+	for u, cached := range v.chains {
+		if !now.Before(cached.expires) {
+			delete(v.chains, u)
+		}
+	}
+
+	for len(v.chains) >= limit {
+		var soonest string
+		for u, cached := range v.chains {
+			if soonest == "" || cached.expires.Before(v.chains[soonest].expires) {
+				soonest = u
+			}
+		}
+		delete(v.chains, soonest)
+	}
+
+	v.chains[chainURL] = c
+}
+
+func (v *Verifier) verifyChain(chain []*x509.Certificate) error {
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	opts := x509.VerifyOptions{
+		DNSName:       certSubjectAltName,
+		Roots:         v.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   v.now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+
+	if _, err := chain[0].Verify(opts); err != nil {
+		return &VerificationError{Reason: "untrusted certificate chain", Err: err}
+	}
+
+	return nil
+}
+
+func parseCertChain(content []byte) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+
+	for {
+		var block *pem.Block
+		block, content = pem.Decode(content)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, &VerificationError{Reason: "malformed certificate", Err: err}
+		}
+
+		chain = append(chain, cert)
+	}
+
+	if len(chain) == 0 {
+		return nil, &VerificationError{Reason: "certificate chain holds no certificates"}
+	}
+
+	return chain, nil
+}
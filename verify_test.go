@@ -0,0 +1,303 @@
+package alexado
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testCertChainURL = "https://s3.amazonaws.com/echo.api/echo-api-cert.pem"
+
+var testRequestTime = time.Date(2019, 2, 23, 5, 26, 19, 0, time.UTC)
+
+var testSignedBody = []byte(`{"version":"1.0","request":{"type":"LaunchRequest","requestId":"amzn1.echo-api.request.1","timestamp":"2019-02-23T05:26:19Z","locale":"en-US"}}`)
+
+type testPKI struct {
+	roots *x509.CertPool
+	chain []byte
+	key   *rsa.PrivateKey
+}
+
+func newTestPKI(t *testing.T, dnsName string) testPKI {
+	t.Helper()
+
+	rootKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             testRequestTime.Add(-24 * time.Hour),
+		NotAfter:              testRequestTime.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, _ := x509.ParseCertificate(rootDER)
+
+	leafKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    testRequestTime.Add(-time.Hour),
+		NotAfter:     testRequestTime.Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, root, &leafKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	return testPKI{
+		roots: roots,
+		chain: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}),
+		key:   leafKey,
+	}
+}
+
+func (p testPKI) sign(t *testing.T, body []byte) string {
+	t.Helper()
+
+	digest := sha256.Sum256(body)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+func (p testPKI) verifier(now time.Time) *Verifier {
+	return &Verifier{
+		Roots:   p.roots,
+		Fetcher: CertFetcherFunc(func(string) ([]byte, error) { return p.chain, nil }),
+		Now:     func() time.Time { return now },
+	}
+}
+
+func signedHeader(signature string) http.Header {
+	header := http.Header{}
+	header.Set(SignatureCertChainURLHeader, testCertChainURL)
+	header.Set(SignatureHeader, signature)
+
+	return header
+}
+
+func TestVerifyAcceptsSignedRequest(t *testing.T) {
+	pki := newTestPKI(t, "echo-api.amazon.com")
+	v := pki.verifier(testRequestTime.Add(time.Minute))
+
+	if err := v.Verify(signedHeader(pki.sign(t, testSignedBody)), testSignedBody); err != nil {
+		t.Errorf("Expected request to verify, got %v", err)
+	}
+}
+
+func TestVerifyRejectsTamperedBody(t *testing.T) {
+	pki := newTestPKI(t, "echo-api.amazon.com")
+	v := pki.verifier(testRequestTime)
+
+	header := signedHeader(pki.sign(t, testSignedBody))
+	tampered := append([]byte{}, testSignedBody...)
+	tampered[len(tampered)-2] = ' '
+
+	if _, ok := v.Verify(header, tampered).(*VerificationError); !ok {
+		t.Error("Expected tampered body to be rejected")
+	}
+}
+
+func TestVerifyRejectsMissingHeaders(t *testing.T) {
+	pki := newTestPKI(t, "echo-api.amazon.com")
+	v := pki.verifier(testRequestTime)
+
+	if _, ok := v.Verify(http.Header{}, testSignedBody).(*VerificationError); !ok {
+		t.Error("Expected missing headers to be rejected")
+	}
+}
+
+func TestVerifyRejectsUntrustedChain(t *testing.T) {
+	pki := newTestPKI(t, "echo-api.amazon.com")
+	other := newTestPKI(t, "echo-api.amazon.com")
+	v := pki.verifier(testRequestTime)
+	v.Roots = other.roots
+
+	if _, ok := v.Verify(signedHeader(pki.sign(t, testSignedBody)), testSignedBody).(*VerificationError); !ok {
+		t.Error("Expected untrusted chain to be rejected")
+	}
+}
+
+func TestVerifyRejectsWrongSubjectAltName(t *testing.T) {
+	pki := newTestPKI(t, "example.com")
+	v := pki.verifier(testRequestTime)
+
+	if _, ok := v.Verify(signedHeader(pki.sign(t, testSignedBody)), testSignedBody).(*VerificationError); !ok {
+		t.Error("Expected certificate without echo-api.amazon.com to be rejected")
+	}
+}
+
+func TestVerifierCertChainCache(t *testing.T) {
+	pki := newTestPKI(t, "echo-api.amazon.com")
+
+	fetches := 0
+	now := testRequestTime
+	v := &Verifier{
+		Roots:     pki.roots,
+		Fetcher:   CertFetcherFunc(func(string) ([]byte, error) { fetches++; return pki.chain, nil }),
+		Now:       func() time.Time { return now },
+		MaxChains: 2,
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := v.certChain(testCertChainURL); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if fetches != 1 {
+		t.Errorf("'%d' != '%d'", fetches, 1)
+	}
+
+	for _, name := range []string{"a.pem", "b.pem", "c.pem"} {
+		if _, err := v.certChain("https://s3.amazonaws.com/echo.api/" + name); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if len(v.chains) != 2 {
+		t.Errorf("'%d' != '%d'", len(v.chains), 2)
+	}
+
+	// The leaf certificate expires an hour after testRequestTime, so the cached chain is dropped and fetched again
+	now = testRequestTime.Add(2 * time.Hour)
+	fetches = 0
+
+	if _, err := v.certChain("https://s3.amazonaws.com/echo.api/c.pem"); err == nil {
+		t.Error("Expected the expired chain to be rejected")
+	}
+
+	if fetches != 1 {
+		t.Errorf("'%d' != '%d'", fetches, 1)
+	}
+
+	if len(v.chains) != 1 {
+		t.Errorf("'%d' != '%d'", len(v.chains), 1)
+	}
+}
+
+func TestHTTPCertFetcher(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow.pem":
+			select {
+			case <-r.Context().Done():
+			case <-done:
+			}
+		case "/large.pem":
+			w.Write(bytes.Repeat([]byte("a"), MaxCertChainSize+1))
+		default:
+			w.Write([]byte("chain"))
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	f := HTTPCertFetcher{Client: server.Client(), Timeout: 50 * time.Millisecond}
+
+	content, err := f.FetchCertChain(server.URL + "/chain.pem")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if string(content) != "chain" {
+		t.Errorf("'%s' != '%s'", content, "chain")
+	}
+
+	start := time.Now()
+	if _, err := f.FetchCertChain(server.URL + "/slow.pem"); err == nil {
+		t.Error("Expected a stalled download to time out")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the download to stop after its timeout, took %s", elapsed)
+	}
+
+	if _, err := f.FetchCertChain(server.URL + "/large.pem"); err == nil {
+		t.Error("Expected an oversized chain to be rejected")
+	}
+}
+
+func TestVerifyRejectsStaleTimestamp(t *testing.T) {
+	pki := newTestPKI(t, "echo-api.amazon.com")
+
+	for _, now := range []time.Time{testRequestTime.Add(151 * time.Second), testRequestTime.Add(-151 * time.Second)} {
+		v := pki.verifier(now)
+		if _, ok := v.Verify(signedHeader(pki.sign(t, testSignedBody)), testSignedBody).(*VerificationError); !ok {
+			t.Errorf("Expected request verified at %s to be rejected", now)
+		}
+	}
+
+	v := pki.verifier(testRequestTime.Add(150 * time.Second))
+	if err := v.VerifyTimestamp(testSignedBody); err != nil {
+		t.Errorf("Expected timestamp at the edge of the tolerance to verify, got %v", err)
+	}
+}
+
+func TestValidateCertChainURL(t *testing.T) {
+	valid := []string{
+		"https://s3.amazonaws.com/echo.api/echo-api-cert.pem",
+		"https://s3.amazonaws.com:443/echo.api/echo-api-cert.pem",
+		"https://s3.amazonaws.com/echo.api/../echo.api/echo-api-cert.pem",
+		"HTTPS://s3.amazonaws.com/echo.api/echo-api-cert.pem",
+		"https://S3.AMAZONAWS.COM/echo.api/echo-api-cert.pem",
+	}
+
+	for _, u := range valid {
+		if err := ValidateCertChainURL(u); err != nil {
+			t.Errorf("Expected '%s' to be valid, got %v", u, err)
+		}
+	}
+
+	invalid := []string{
+		"http://s3.amazonaws.com/echo.api/echo-api-cert.pem",
+		"https://notamazon.com/echo.api/echo-api-cert.pem",
+		"https://s3.amazonaws.com/EcHo.aPi/echo-api-cert.pem",
+		"https://s3.amazonaws.com/invalid.path/echo-api-cert.pem",
+		"https://s3.amazonaws.com:563/echo.api/echo-api-cert.pem",
+		"https://s3.amazonaws.com/echo.api/../invalid.path/echo-api-cert.pem",
+	}
+
+	for _, u := range invalid {
+		if err := ValidateCertChainURL(u); err == nil {
+			t.Errorf("Expected '%s' to be invalid", u)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package alexado
+
+// The classification below follows the viewport profiles published for APL. Devices without a screen send no
+// Viewport, which classifies as NoScreen.
+
+// SizeGroup returns the size class of a viewport dimension in pixels.
+func SizeGroup(pixels int) ViewportSizeGroup {
+	switch {
+	case pixels < 600:
+		return SizeXSmall
+	case pixels < 960:
+		return SizeSmall
+	case pixels < 1280:
+		return SizeMedium
+	case pixels < 1920:
+		return SizeLarge
+	default:
+		return SizeXLarge
+	}
+}
+
+// DPIGroup returns the pixel density class of a viewport.
+func DPIGroup(dpi int) ViewportDPIGroup {
+	switch {
+	case dpi <= 120:
+		return DPIXLow
+	case dpi <= 160:
+		return DPILow
+	case dpi <= 240:
+		return DPIMedium
+	case dpi <= 320:
+		return DPIHigh
+	case dpi <= 480:
+		return DPIXHigh
+	default:
+		return DPIXXHigh
+	}
+}
+
+// HasScreen reports whether v describes a screen. Requests from devices without one carry a zero Viewport.
+func (v Viewport) HasScreen() bool {
+	return v.PixelWidth > 0 && v.PixelHeight > 0
+}
+
+// WidthGroup returns the size class of the width of v.
+func (v Viewport) WidthGroup() ViewportSizeGroup {
+	return SizeGroup(v.PixelWidth)
+}
+
+// HeightGroup returns the size class of the height of v.
+func (v Viewport) HeightGroup() ViewportSizeGroup {
+	return SizeGroup(v.PixelHeight)
+}
+
+// DPIGroup returns the pixel density class of v.
+func (v Viewport) DPIGroup() ViewportDPIGroup {
+	return DPIGroup(v.DPI)
+}
+
+// Orientation returns the orientation of v.
+func (v Viewport) Orientation() ViewportOrientation {
+	switch {
+	case v.PixelWidth > v.PixelHeight:
+		return Landscape
+	case v.PixelWidth < v.PixelHeight:
+		return Portrait
+	default:
+		return Equal
+	}
+}
+
+// Profile returns the viewport profile of v, NoScreen when v describes no screen and UnknownViewportProfile when
+// it matches none of the published profiles, including when its shape is unset or unknown.
+func (v Viewport) Profile() ViewportProfile {
+	if !v.HasScreen() {
+		return NoScreen
+	}
+
+	orientation, dpi := v.Orientation(), v.DPIGroup()
+	width, height := v.WidthGroup(), v.HeightGroup()
+
+	if v.Shape == Round {
+		if orientation == Equal && width == SizeXSmall && height == SizeXSmall && dpi == DPILow {
+			return HubRoundSmall
+		}
+
+		return UnknownViewportProfile
+	}
+
+	if v.Shape != Rectangle {
+		return UnknownViewportProfile
+	}
+
+	switch {
+	case orientation == Landscape && dpi == DPILow:
+		switch {
+		case width <= SizeMedium && height <= SizeXSmall:
+			return HubLandscapeSmall
+		case width <= SizeMedium && height <= SizeSmall:
+			return HubLandscapeMedium
+		case width >= SizeLarge && height >= SizeSmall:
+			return HubLandscapeLarge
+		}
+	case orientation == Landscape && dpi == DPIMedium:
+		switch {
+		case width >= SizeMedium && height >= SizeSmall:
+			return MobileLandscapeMedium
+		case width >= SizeSmall && height >= SizeXSmall:
+			return MobileLandscapeSmall
+		}
+	case orientation == Portrait && dpi == DPIMedium:
+		switch {
+		case width >= SizeSmall && height >= SizeMedium:
+			return MobilePortraitMedium
+		case width >= SizeXSmall && height >= SizeSmall:
+			return MobilePortraitSmall
+		}
+	case orientation == Landscape && dpi >= DPIHigh:
+		switch {
+		case width >= SizeXLarge && height >= SizeMedium:
+			return TVLandscapeXLarge
+		case width == SizeMedium && height == SizeSmall:
+			return TVLandscapeMedium
+		}
+	case orientation == Portrait && dpi >= DPIHigh:
+		if width == SizeXSmall && height == SizeXLarge {
+			return TVPortraitMedium
+		}
+	}
+
+	return UnknownViewportProfile
+}
+
+// ViewportProfile returns the viewport profile of the device sending a.
+func (a AlexaRequest) ViewportProfile() ViewportProfile {
+	return a.Context.Viewport.Profile()
+}
+
+// ViewportProfile is a class of devices with similar screens
+type ViewportProfile int
+
+const (
+	// HubRoundSmall is a small round hub, such as the Echo Spot
+	HubRoundSmall ViewportProfile = iota + 1
+	// HubLandscapeSmall is a small landscape hub, such as the Echo Show 5
+	HubLandscapeSmall
+	// HubLandscapeMedium is a medium landscape hub
+	HubLandscapeMedium
+	// HubLandscapeLarge is a large landscape hub
+	HubLandscapeLarge
+	// MobileLandscapeSmall is a small tablet in landscape orientation
+	MobileLandscapeSmall
+	// MobilePortraitSmall is a small tablet in portrait orientation
+	MobilePortraitSmall
+	// MobileLandscapeMedium is a medium tablet in landscape orientation
+	MobileLandscapeMedium
+	// MobilePortraitMedium is a medium tablet in portrait orientation
+	MobilePortraitMedium
+	// TVLandscapeXLarge is a television, such as a Fire TV
+	TVLandscapeXLarge
+	// TVLandscapeMedium is a screen overlay on a television
+	TVLandscapeMedium
+	// TVPortraitMedium is a vertical overlay on a television
+	TVPortraitMedium
+	// NoScreen is a device without a screen
+	NoScreen
+	// UnknownViewportProfile is a screen matching none of the published profiles
+	UnknownViewportProfile
+)
+
+var viewportProfileNames = [...]string{
+	"",
+	"HUB-ROUND-SMALL",
+	"HUB-LANDSCAPE-SMALL",
+	"HUB-LANDSCAPE-MEDIUM",
+	"HUB-LANDSCAPE-LARGE",
+	"MOBILE-LANDSCAPE-SMALL",
+	"MOBILE-PORTRAIT-SMALL",
+	"MOBILE-LANDSCAPE-MEDIUM",
+	"MOBILE-PORTRAIT-MEDIUM",
+	"TV-LANDSCAPE-XLARGE",
+	"TV-LANDSCAPE-MEDIUM",
+	"TV-PORTRAIT-MEDIUM",
+	"NO-SCREEN",
+	"UNKNOWN-VIEWPORT-PROFILE",
+}
+
+func (p ViewportProfile) String() string {
+	return enumString(viewportProfileNames[:], int(p))
+}
+
+// IsValid reports whether p is one of the ViewportProfile values. The unset zero value is not valid.
+func (p ViewportProfile) IsValid() bool {
+	return validEnum(viewportProfileNames[:], int(p))
+}
+
+// ViewportProfileValues returns every ViewportProfile value in declaration order.
+func ViewportProfileValues() []ViewportProfile {
+	values := make([]ViewportProfile, len(viewportProfileNames)-1)
+	for i := range values {
+		values[i] = ViewportProfile(i + 1)
+	}
+
+	return values
+}
+
+// IsHub reports whether p is one of the hub profiles.
+func (p ViewportProfile) IsHub() bool {
+	return p >= HubRoundSmall && p <= HubLandscapeLarge
+}
+
+// IsMobile reports whether p is one of the mobile profiles.
+func (p ViewportProfile) IsMobile() bool {
+	return p >= MobileLandscapeSmall && p <= MobilePortraitMedium
+}
+
+// IsTV reports whether p is one of the television profiles.
+func (p ViewportProfile) IsTV() bool {
+	return p >= TVLandscapeXLarge && p <= TVPortraitMedium
+}
+
+// ViewportSizeGroup is the size class of a viewport dimension
+type ViewportSizeGroup int
+
+const (
+	// SizeXSmall is below 600 pixels
+	SizeXSmall ViewportSizeGroup = iota + 1
+	// SizeSmall is from 600 to 959 pixels
+	SizeSmall
+	// SizeMedium is from 960 to 1279 pixels
+	SizeMedium
+	// SizeLarge is from 1280 to 1919 pixels
+	SizeLarge
+	// SizeXLarge is 1920 pixels and above
+	SizeXLarge
+)
+
+var viewportSizeGroupNames = [...]string{
+	"",
+	"XSMALL",
+	"SMALL",
+	"MEDIUM",
+	"LARGE",
+	"XLARGE",
+}
+
+func (s ViewportSizeGroup) String() string {
+	return enumString(viewportSizeGroupNames[:], int(s))
+}
+
+// IsValid reports whether s is one of the ViewportSizeGroup values. The unset zero value is not valid.
+func (s ViewportSizeGroup) IsValid() bool {
+	return validEnum(viewportSizeGroupNames[:], int(s))
+}
+
+// ViewportSizeGroupValues returns every ViewportSizeGroup value in declaration order.
+func ViewportSizeGroupValues() []ViewportSizeGroup {
+	values := make([]ViewportSizeGroup, len(viewportSizeGroupNames)-1)
+	for i := range values {
+		values[i] = ViewportSizeGroup(i + 1)
+	}
+
+	return values
+}
+
+// ViewportDPIGroup is the pixel density class of a viewport
+type ViewportDPIGroup int
+
+const (
+	// DPIXLow is up to 120 dpi
+	DPIXLow ViewportDPIGroup = iota + 1
+	// DPILow is up to 160 dpi
+	DPILow
+	// DPIMedium is up to 240 dpi
+	DPIMedium
+	// DPIHigh is up to 320 dpi
+	DPIHigh
+	// DPIXHigh is up to 480 dpi
+	DPIXHigh
+	// DPIXXHigh is above 480 dpi
+	DPIXXHigh
+)
+
+var viewportDPIGroupNames = [...]string{
+	"",
+	"XLOW",
+	"LOW",
+	"MEDIUM",
+	"HIGH",
+	"XHIGH",
+	"XXHIGH",
+}
+
+func (d ViewportDPIGroup) String() string {
+	return enumString(viewportDPIGroupNames[:], int(d))
+}
+
+// IsValid reports whether d is one of the ViewportDPIGroup values. The unset zero value is not valid.
+func (d ViewportDPIGroup) IsValid() bool {
+	return validEnum(viewportDPIGroupNames[:], int(d))
+}
+
+// ViewportDPIGroupValues returns every ViewportDPIGroup value in declaration order.
+func ViewportDPIGroupValues() []ViewportDPIGroup {
+	values := make([]ViewportDPIGroup, len(viewportDPIGroupNames)-1)
+	for i := range values {
+		values[i] = ViewportDPIGroup(i + 1)
+	}
+
+	return values
+}
+
+// ViewportOrientation is the orientation of a viewport
+type ViewportOrientation int
+
+const (
+	// Landscape is wider than it is high
+	Landscape ViewportOrientation = iota + 1
+	// Portrait is higher than it is wide
+	Portrait
+	// Equal is as wide as it is high
+	Equal
+)
+
+var viewportOrientationNames = [...]string{
+	"",
+	"LANDSCAPE",
+	"PORTRAIT",
+	"EQUAL",
+}
+
+func (o ViewportOrientation) String() string {
+	return enumString(viewportOrientationNames[:], int(o))
+}
+
+// IsValid reports whether o is one of the ViewportOrientation values. The unset zero value is not valid.
+func (o ViewportOrientation) IsValid() bool {
+	return validEnum(viewportOrientationNames[:], int(o))
+}
+
+// ViewportOrientationValues returns every ViewportOrientation value in declaration order.
+func ViewportOrientationValues() []ViewportOrientation {
+	values := make([]ViewportOrientation, len(viewportOrientationNames)-1)
+	for i := range values {
+		values[i] = ViewportOrientation(i + 1)
+	}
+
+	return values
+}
@@ -0,0 +1,128 @@
+package alexado
+
+import "testing"
+
+func TestViewportProfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		viewport    Viewport
+		profile     ViewportProfile
+		width       ViewportSizeGroup
+		height      ViewportSizeGroup
+		dpi         ViewportDPIGroup
+		orientation ViewportOrientation
+	}{
+		{"round hub", Viewport{Shape: Round, PixelWidth: 480, PixelHeight: 480, DPI: 160}, HubRoundSmall, SizeXSmall, SizeXSmall, DPILow, Equal},
+		{"small hub", Viewport{Shape: Rectangle, PixelWidth: 960, PixelHeight: 480, DPI: 160}, HubLandscapeSmall, SizeMedium, SizeXSmall, DPILow, Landscape},
+		{"medium hub", Viewport{Shape: Rectangle, PixelWidth: 1024, PixelHeight: 600, DPI: 160}, HubLandscapeMedium, SizeMedium, SizeSmall, DPILow, Landscape},
+		{"large hub", Viewport{Shape: Rectangle, PixelWidth: 1280, PixelHeight: 800, DPI: 160}, HubLandscapeLarge, SizeLarge, SizeSmall, DPILow, Landscape},
+		{"small tablet landscape", Viewport{Shape: Rectangle, PixelWidth: 600, PixelHeight: 400, DPI: 240}, MobileLandscapeSmall, SizeSmall, SizeXSmall, DPIMedium, Landscape},
+		{"small tablet portrait", Viewport{Shape: Rectangle, PixelWidth: 400, PixelHeight: 600, DPI: 240}, MobilePortraitSmall, SizeXSmall, SizeSmall, DPIMedium, Portrait},
+		{"medium tablet landscape", Viewport{Shape: Rectangle, PixelWidth: 1024, PixelHeight: 600, DPI: 240}, MobileLandscapeMedium, SizeMedium, SizeSmall, DPIMedium, Landscape},
+		{"medium tablet portrait", Viewport{Shape: Rectangle, PixelWidth: 600, PixelHeight: 1024, DPI: 240}, MobilePortraitMedium, SizeSmall, SizeMedium, DPIMedium, Portrait},
+		{"television", Viewport{Shape: Rectangle, PixelWidth: 1920, PixelHeight: 1080, DPI: 320}, TVLandscapeXLarge, SizeXLarge, SizeMedium, DPIHigh, Landscape},
+		{"television overlay", Viewport{Shape: Rectangle, PixelWidth: 960, PixelHeight: 600, DPI: 320}, TVLandscapeMedium, SizeMedium, SizeSmall, DPIHigh, Landscape},
+		{"television portrait overlay", Viewport{Shape: Rectangle, PixelWidth: 300, PixelHeight: 1920, DPI: 480}, TVPortraitMedium, SizeXSmall, SizeXLarge, DPIXHigh, Portrait},
+		{"large round", Viewport{Shape: Round, PixelWidth: 1024, PixelHeight: 1024, DPI: 160}, UnknownViewportProfile, SizeMedium, SizeMedium, DPILow, Equal},
+		{"square", Viewport{Shape: Rectangle, PixelWidth: 600, PixelHeight: 600, DPI: 160}, UnknownViewportProfile, SizeSmall, SizeSmall, DPILow, Equal},
+		{"low density", Viewport{Shape: Rectangle, PixelWidth: 1024, PixelHeight: 600, DPI: 96}, UnknownViewportProfile, SizeMedium, SizeSmall, DPIXLow, Landscape},
+		{"unset shape", Viewport{PixelWidth: 1280, PixelHeight: 800, DPI: 160}, UnknownViewportProfile, SizeLarge, SizeSmall, DPILow, Landscape},
+		{"unset shape television", Viewport{PixelWidth: 1920, PixelHeight: 1080, DPI: 320}, UnknownViewportProfile, SizeXLarge, SizeMedium, DPIHigh, Landscape},
+		{"no screen", Viewport{}, NoScreen, SizeXSmall, SizeXSmall, DPIXLow, Equal},
+	}
+
+	for _, test := range tests {
+		v := test.viewport
+
+		if actual := v.Profile(); actual != test.profile {
+			t.Errorf("%s: '%s' != '%s'", test.name, actual, test.profile)
+		}
+
+		if actual := v.WidthGroup(); actual != test.width {
+			t.Errorf("%s: '%s' != '%s'", test.name, actual, test.width)
+		}
+
+		if actual := v.HeightGroup(); actual != test.height {
+			t.Errorf("%s: '%s' != '%s'", test.name, actual, test.height)
+		}
+
+		if actual := v.DPIGroup(); actual != test.dpi {
+			t.Errorf("%s: '%s' != '%s'", test.name, actual, test.dpi)
+		}
+
+		if actual := v.Orientation(); actual != test.orientation {
+			t.Errorf("%s: '%s' != '%s'", test.name, actual, test.orientation)
+		}
+	}
+}
+
+func TestSizeGroupBoundaries(t *testing.T) {
+	tests := []struct {
+		pixels   int
+		expected ViewportSizeGroup
+	}{
+		{0, SizeXSmall}, {599, SizeXSmall}, {600, SizeSmall}, {959, SizeSmall}, {960, SizeMedium},
+		{1279, SizeMedium}, {1280, SizeLarge}, {1919, SizeLarge}, {1920, SizeXLarge}, {3840, SizeXLarge},
+	}
+
+	for _, test := range tests {
+		if actual := SizeGroup(test.pixels); actual != test.expected {
+			t.Errorf("%d: '%s' != '%s'", test.pixels, actual, test.expected)
+		}
+	}
+}
+
+func TestDPIGroupBoundaries(t *testing.T) {
+	tests := []struct {
+		dpi      int
+		expected ViewportDPIGroup
+	}{
+		{120, DPIXLow}, {121, DPILow}, {160, DPILow}, {161, DPIMedium}, {240, DPIMedium},
+		{241, DPIHigh}, {320, DPIHigh}, {321, DPIXHigh}, {480, DPIXHigh}, {481, DPIXXHigh},
+	}
+
+	for _, test := range tests {
+		if actual := DPIGroup(test.dpi); actual != test.expected {
+			t.Errorf("%d: '%s' != '%s'", test.dpi, actual, test.expected)
+		}
+	}
+}
+
+func TestViewportProfileFamilies(t *testing.T) {
+	tests := []struct {
+		profile         ViewportProfile
+		hub, mobile, tv bool
+	}{
+		{HubRoundSmall, true, false, false},
+		{HubLandscapeLarge, true, false, false},
+		{MobileLandscapeSmall, false, true, false},
+		{MobilePortraitMedium, false, true, false},
+		{TVLandscapeXLarge, false, false, true},
+		{TVPortraitMedium, false, false, true},
+		{NoScreen, false, false, false},
+		{UnknownViewportProfile, false, false, false},
+	}
+
+	for _, test := range tests {
+		if test.profile.IsHub() != test.hub || test.profile.IsMobile() != test.mobile || test.profile.IsTV() != test.tv {
+			t.Errorf("Unexpected family for %s", test.profile)
+		}
+	}
+
+	actual, expected := HubRoundSmall.String(), "HUB-ROUND-SMALL"
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}
+
+func TestRequestViewportProfile(t *testing.T) {
+	actual, expected := readAPLUserEvent(t).ViewportProfile(), HubLandscapeMedium
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+
+	actual, expected = newTestRequest(LaunchRequest, "").ViewportProfile(), NoScreen
+	if actual != expected {
+		t.Errorf("'%s' != '%s'", actual, expected)
+	}
+}